@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/grovetools/core/logging"
+	"github.com/grovetools/skills/pkg/skills"
+	"github.com/spf13/cobra"
+)
+
+func newSkillsBundleCmd() *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "bundle <name>...",
+		Short: "Package several skills into a single tar.gz archive",
+		Long: `Package one or more skills into a single gzipped tar archive.
+
+Each skill is resolved with the normal source precedence (builtin, user,
+ecosystem, project) and stored under skills/<name>/ in the archive. A
+manifest.json at the root lists every included skill with its source,
+description, file list and a content digest used as its version.`,
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: completeSkillNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output = resolvePath(output)
+			logger := logging.NewPrettyLogger()
+			svc := GetService()
+
+			cwd, err := workingDir()
+			if err != nil {
+				return fmt.Errorf("could not get current directory: %w", err)
+			}
+
+			node, err := svc.ResolveNode(cwd)
+			if err != nil {
+				node = nil
+			}
+			if svc == nil && node != nil {
+				if svc, err = skills.NewServiceForNode(node); err != nil {
+					svc = nil
+				}
+			}
+
+			var loaded []*skills.LoadedSkill
+			seen := make(map[string]bool)
+			for _, name := range args {
+				if seen[name] {
+					continue
+				}
+				seen[name] = true
+				skill, err := skills.LoadSkillBypassingAccessWithService(svc, node, name)
+				if err != nil {
+					return err
+				}
+				loaded = append(loaded, skill)
+			}
+
+			f, err := os.Create(output) //nolint:gosec // G304: user-specified output path
+			if err != nil {
+				return fmt.Errorf("failed to create %s: %w", output, err)
+			}
+			if err := skills.WriteBundle(f, loaded); err != nil {
+				_ = f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return fmt.Errorf("failed to write %s: %w", output, err)
+			}
+
+			logger.Success(fmt.Sprintf("Bundled %d skills.", len(loaded)))
+			logger.Path("  Wrote", output)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "skills-bundle.tar.gz", "Path of the archive to write")
+	return cmd
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/grovetools/skills/pkg/skills"
+	"github.com/spf13/cobra"
+)
+
+func newSkillsCatCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cat <skill-name> [file]",
+		Short: "Print one file of a skill",
+		Long: `Print the raw content of a single file from a skill, without installing it.
+
+The skill is resolved across all sources with the standard precedence, like
+'show'. The file is a path relative to the skill directory (e.g.
+references/api.md) and defaults to SKILL.md. If the file is not part of the
+skill, the available files are listed.`,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completeSkillNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			skillName := args[0]
+			file := "SKILL.md"
+			if len(args) == 2 {
+				file = args[1]
+			}
+			svc := GetService()
+
+			cwd, err := workingDir()
+			if err != nil {
+				return fmt.Errorf("could not get current directory: %w", err)
+			}
+
+			node, err := svc.ResolveNode(cwd)
+			if err != nil {
+				// Not in a workspace, but builtin/user skills still resolve
+				node = nil
+			}
+			if svc == nil && node != nil {
+				svc, err = skills.NewServiceForNode(node)
+				if err != nil {
+					svc = nil
+				}
+			}
+
+			loadedSkill, err := skills.LoadSkillBypassingAccessWithService(svc, node, skillName)
+			if err != nil {
+				return err
+			}
+
+			content, ok := loadedSkill.Files[filepath.Clean(filepath.FromSlash(file))]
+			if !ok {
+				available := make([]string, 0, len(loadedSkill.Files))
+				for rel := range loadedSkill.Files {
+					available = append(available, filepath.ToSlash(rel))
+				}
+				sort.Strings(available)
+				return fmt.Errorf("skill '%s' has no file '%s' (available: %s)", loadedSkill.Name, file, strings.Join(available, ", "))
+			}
+
+			_, err = os.Stdout.Write(content)
+			return err
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grovetools/core/logging"
+	"github.com/grovetools/skills/pkg/skills"
+	"github.com/spf13/cobra"
+)
+
+func newSkillsCompareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compare <skill-a> <skill-b>",
+		Short: "Show how two skills differ",
+		Long: `Compare the files of two skills, e.g. a builtin and a customized copy you keep
+under a different name, to decide whether the customization is still needed.
+
+Both skills are resolved across all sources with the standard precedence, like
+'show'. The files present in only one skill are listed, followed by a unified
+diff from skill-a to skill-b. This compares two source skills; to see what sync
+would change in an installed copy use 'sync --dry-run --diff'.`,
+		Args: cobra.ExactArgs(2),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) >= 2 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return completeSkillNames(cmd, nil, toComplete)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := logging.NewPrettyLogger()
+			svc := GetService()
+
+			cwd, err := workingDir()
+			if err != nil {
+				return fmt.Errorf("could not get current directory: %w", err)
+			}
+
+			node, err := svc.ResolveNode(cwd)
+			if err != nil {
+				// Not in a workspace, but builtin/user skills still resolve
+				node = nil
+			}
+			if svc == nil && node != nil {
+				svc, err = skills.NewServiceForNode(node)
+				if err != nil {
+					svc = nil
+				}
+			}
+
+			left, err := skills.LoadSkillBypassingAccessWithService(svc, node, args[0])
+			if err != nil {
+				return err
+			}
+			right, err := skills.LoadSkillBypassingAccessWithService(svc, node, args[1])
+			if err != nil {
+				return err
+			}
+
+			cmp := skills.CompareSkills(left, right)
+			if cmp.Empty() {
+				logger.InfoPretty(fmt.Sprintf("'%s' (%s) and '%s' (%s) have identical files", left.Name, left.SourceType, right.Name, right.SourceType))
+				return nil
+			}
+
+			logger.InfoPretty(fmt.Sprintf("Comparing '%s' (%s) with '%s' (%s): %d changed, %d only in '%s', %d only in '%s'",
+				left.Name, left.SourceType, right.Name, right.SourceType,
+				len(cmp.Changed), len(cmp.OnlyLeft), left.Name, len(cmp.OnlyRight), right.Name))
+			for _, p := range cmp.OnlyLeft {
+				logger.InfoPretty(fmt.Sprintf("  only in %s: %s", left.Name, p))
+			}
+			for _, p := range cmp.OnlyRight {
+				logger.InfoPretty(fmt.Sprintf("  only in %s: %s", right.Name, p))
+			}
+			fmt.Print(cmp.Diff)
+			return nil
+		},
+	}
+	return cmd
+}
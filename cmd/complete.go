@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grovetools/skills/pkg/skills"
+	"github.com/spf13/cobra"
+)
+
+// completeSkillsCmdName is skipped by PersistentPreRunE so completion never
+// pays for full workspace discovery.
+const completeSkillsCmdName = "__complete-skills"
+
+func newCompleteSkillsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:    completeSkillsCmdName,
+		Short:  "Print skill names for shell completion",
+		Hidden: true,
+		Args:   cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			for _, name := range skills.QuickSkillNames(GetService()) {
+				fmt.Println(name)
+			}
+		},
+	}
+}
+
+// completeSkillNames is a ValidArgsFunction for commands taking a single skill name.
+func completeSkillNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var matches []string
+	for _, name := range skills.QuickSkillNames(GetService()) {
+		if strings.HasPrefix(name, toComplete) {
+			matches = append(matches, name)
+		}
+	}
+	return matches, cobra.ShellCompDirectiveNoFileComp
+}
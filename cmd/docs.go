@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/grovetools/core/logging"
+	"github.com/grovetools/skills/pkg/skills"
+	"github.com/spf13/cobra"
+)
+
+func newSkillsDocsCmd() *cobra.Command {
+	var outputDir string
+	var sources []string
+
+	cmd := &cobra.Command{
+		Use:   "docs",
+		Short: "Generate markdown documentation pages for available skills",
+		Long: `Generate a markdown catalog of the available skills.
+
+For each skill, <output-dir>/<name>.md combines its frontmatter (description,
+source, version digest, domain, aliases, requires) with the SKILL.md body.
+An index.md links every page. Skills are discovered with the same precedence
+as 'list'.
+
+Use --source (repeatable) to document only some tiers, e.g. --source builtin.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputDir = resolvePath(outputDir)
+			for _, src := range sources {
+				if !slices.Contains(skills.SourceTiers, src) {
+					return fmt.Errorf("invalid --source %q (valid: %s)", src, strings.Join(skills.SourceTiers, ", "))
+				}
+			}
+
+			logger := logging.NewPrettyLogger()
+			svc := GetService()
+
+			cwd, err := workingDir()
+			if err != nil {
+				return fmt.Errorf("could not get current directory: %w", err)
+			}
+			node, err := svc.ResolveNode(cwd)
+			if err != nil {
+				node = nil
+			}
+
+			var exclude []string
+			if len(sources) > 0 {
+				for _, tier := range skills.SourceTiers {
+					if !slices.Contains(sources, tier) {
+						exclude = append(exclude, tier)
+					}
+				}
+			}
+			found := skills.ListSkillSourcesWithOptions(svc, node, skills.DiscoveryOptions{ExcludeSources: exclude})
+
+			names := make([]string, 0, len(found))
+			for name := range found {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			if err := os.MkdirAll(outputDir, 0o755); err != nil { //nolint:gosec // G301: docs output dir
+				return fmt.Errorf("failed to create %s: %w", outputDir, err)
+			}
+
+			var documented []*skills.LoadedSkill
+			for _, name := range names {
+				loaded, err := skills.LoadSkillFromSource(name, found[name])
+				if err != nil {
+					logger.WarnPretty(fmt.Sprintf("Skipping %s: %v", name, err))
+					continue
+				}
+				page, err := skills.RenderSkillDoc(loaded)
+				if err != nil {
+					logger.WarnPretty(fmt.Sprintf("Skipping %s: %v", name, err))
+					continue
+				}
+				if err := os.WriteFile(filepath.Join(outputDir, skills.SkillDocFileName(name)), page, 0o644); err != nil { //nolint:gosec // G306: generated docs
+					return err
+				}
+				documented = append(documented, loaded)
+			}
+
+			indexPath := filepath.Join(outputDir, "index.md")
+			if err := os.WriteFile(indexPath, skills.RenderDocsIndex(documented), 0o644); err != nil { //nolint:gosec // G306: generated docs
+				return err
+			}
+
+			logger.Success(fmt.Sprintf("Documented %d skills.", len(documented)))
+			logger.Path("  Index", indexPath)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&outputDir, "output-dir", "o", "skills-docs", "Directory to write the generated pages to")
+	cmd.Flags().StringSliceVar(&sources, "source", nil, "Only document skills from these tiers (builtin, user, notebook, ecosystem, project, playbook); repeatable")
+	return cmd
+}
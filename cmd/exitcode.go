@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/grovetools/skills/pkg/skills"
+)
+
+// Process exit codes. Scripts and CI can rely on these values.
+const (
+	ExitOK = 0
+	// ExitError is any failure not covered below (bad flags, I/O errors...).
+	ExitError = 1
+	// ExitValidation means the command ran but found invalid or drifted skills.
+	ExitValidation = 2
+	// ExitPartial means a batch operation failed for some, but not all, items.
+	ExitPartial = 3
+	// ExitNotFound means a requested skill (or installed copy) does not exist.
+	ExitNotFound = 4
+)
+
+// exitCodeError attaches an exit code to an error returned from a command.
+type exitCodeError struct {
+	code int
+	err  error
+}
+
+func (e *exitCodeError) Error() string { return e.err.Error() }
+func (e *exitCodeError) Unwrap() error { return e.err }
+
+// withExitCode wraps err so ExitCode reports code for it. A nil err stays nil.
+func withExitCode(code int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &exitCodeError{code: code, err: err}
+}
+
+// ExitCode maps an error returned by Execute to the process exit code.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	var coded *exitCodeError
+	if errors.As(err, &coded) {
+		return coded.code
+	}
+	var notFound *skills.SkillNotFoundError
+	if errors.As(err, &notFound) {
+		return ExitNotFound
+	}
+	return ExitError
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/grovetools/skills/pkg/skills"
+	"github.com/spf13/cobra"
+)
+
+func newSkillsHistoryCmd() *cobra.Command {
+	var skillFilter, since string
+	var jsonOutput bool
+
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Show the log of skill sync, prune and remove actions",
+		Long: `Show the history of changes to installed skills.
+
+Every sync, prune and remove appends a line to
+~/.config/grove/skills-history.log recording the time, action, skill, scope
+(workspace or install scope), provider and source.
+
+Filters:
+  --skill <name>   Only show entries for this skill
+  --since <when>   Only show entries newer than a duration (e.g. 24h, 7d)
+                   or a date (YYYY-MM-DD)`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var cutoff time.Time
+			if since != "" {
+				t, err := parseSince(since)
+				if err != nil {
+					return err
+				}
+				cutoff = t
+			}
+
+			entries, err := skills.ReadHistory()
+			if err != nil {
+				return fmt.Errorf("failed to read history: %w", err)
+			}
+
+			filtered := make([]skills.HistoryEntry, 0, len(entries))
+			for _, e := range entries {
+				if skillFilter != "" && e.Skill != skillFilter {
+					continue
+				}
+				if !cutoff.IsZero() && e.Time.Before(cutoff) {
+					continue
+				}
+				filtered = append(filtered, e)
+			}
+
+			if jsonOutput {
+				out, err := marshalEnvelope("entries", filtered)
+				if err != nil {
+					return fmt.Errorf("failed to marshal JSON: %w", err)
+				}
+				fmt.Println(string(out))
+				return nil
+			}
+
+			if len(filtered) == 0 {
+				fmt.Println("No history entries found.")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintln(w, "TIME\tACTION\tSKILL\tSCOPE\tPROVIDER\tSOURCE")
+			for _, e := range filtered {
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+					e.Time.Local().Format("2006-01-02 15:04:05"), e.Action, e.Skill,
+					dashIfEmpty(e.Scope), dashIfEmpty(e.Provider), dashIfEmpty(e.Source))
+			}
+			return w.Flush()
+		},
+	}
+	cmd.Flags().StringVar(&skillFilter, "skill", "", "Only show entries for this skill")
+	cmd.Flags().StringVar(&since, "since", "", "Only show entries newer than a duration (24h, 7d) or date (YYYY-MM-DD)")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+	return cmd
+}
+
+// parseSince accepts a Go duration, a whole number of days ("7d"), or a date.
+func parseSince(s string) (time.Time, error) {
+	var days int
+	if _, err := fmt.Sscanf(s, "%dd", &days); err == nil && fmt.Sprintf("%dd", days) == s {
+		return time.Now().AddDate(0, 0, -days), nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", s, time.Local); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid --since %q (use a duration like 24h or 7d, or a date like 2024-01-31)", s)
+}
+
+func dashIfEmpty(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/grovetools/skills/pkg/skills"
+	"github.com/spf13/cobra"
+)
+
+// skillInfo is the --json output of the info command.
+type skillInfo struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Source      string          `json:"source"`
+	Path        string          `json:"path"`
+	Files       []skillInfoFile `json:"files"`
+}
+
+// skillInfoFile is one file bundled in a skill.
+type skillInfoFile struct {
+	Path string `json:"path"`
+	Size int    `json:"size"`
+}
+
+func newSkillsInfoCmd() *cobra.Command {
+	var jsonOutput bool
+	cmd := &cobra.Command{
+		Use:   "info <skill-name>",
+		Short: "Show a skill's metadata, source and bundled files",
+		Long: `Show a skill's name and description, the source it resolves from in the
+current workspace, its on-disk path and every file it bundles with its size.
+The source is the one sync would install from.
+
+Exits with 4 if no source defines the skill.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeSkillNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			svc := GetService()
+			cwd, err := workingDir()
+			if err != nil {
+				return fmt.Errorf("could not get current directory: %w", err)
+			}
+			node, err := svc.ResolveNode(cwd)
+			if err != nil {
+				node = nil
+			}
+
+			loaded, err := skills.LoadSkillBypassingAccessWithService(svc, node, args[0])
+			if err != nil {
+				return err
+			}
+			meta, err := skills.ParseSkillFrontmatter(loaded.Files["SKILL.md"])
+			if err != nil {
+				return fmt.Errorf("failed to parse skill metadata: %w", err)
+			}
+
+			info := skillInfo{
+				Name:        meta.Name,
+				Description: meta.Description,
+				Source:      string(loaded.SourceType),
+				Path:        loaded.PhysicalPath,
+				Files:       []skillInfoFile{},
+			}
+			if loaded.SourceType == skills.SourceTypeBuiltin {
+				info.Path = "(builtin)"
+			}
+			for rel, content := range loaded.Files {
+				info.Files = append(info.Files, skillInfoFile{Path: filepath.ToSlash(rel), Size: len(content)})
+			}
+			sort.Slice(info.Files, func(i, j int) bool { return info.Files[i].Path < info.Files[j].Path })
+
+			if jsonOutput {
+				out, err := marshalEnvelope("skill", info)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(out))
+				return nil
+			}
+
+			fmt.Printf("Name:        %s\n", info.Name)
+			fmt.Printf("Description: %s\n", info.Description)
+			fmt.Printf("Source:      %s\n", info.Source)
+			fmt.Printf("Path:        %s\n", info.Path)
+			fmt.Printf("Files:       %d\n", len(info.Files))
+			for _, f := range info.Files {
+				fmt.Printf("  %8d  %s\n", f.Size, f.Path)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+	return cmd
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grovetools/core/logging"
+	"github.com/grovetools/skills/pkg/skills"
+	"github.com/spf13/cobra"
+)
+
+func newSkillsInitCmd() *cobra.Command {
+	var sample bool
+
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Create the user skills directory",
+		Long: `Create the user skills directory, ~/.config/grove/skills (or
+$XDG_CONFIG_HOME/grove/skills), where personal skills available to every
+project live.
+
+Use --sample to also create an example skill, my-first-skill, to copy from.
+Running init again never overwrites existing files.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := logging.NewPrettyLogger()
+
+			result, err := skills.InitUserSkillsDir(sample)
+			if err != nil {
+				return err
+			}
+
+			if result.Created {
+				logger.Success("Created user skills directory")
+			} else {
+				logger.InfoPretty("User skills directory already exists")
+			}
+			logger.Path("  Path", result.Path)
+			if result.SampleCreated {
+				logger.Success(fmt.Sprintf("Created sample skill '%s'", skills.SampleSkillName))
+				logger.Path("  Path", result.SamplePath)
+			} else if result.SamplePath != "" {
+				logger.InfoPretty(fmt.Sprintf("Sample skill '%s' already exists", skills.SampleSkillName))
+			}
+
+			logger.InfoPretty("Next steps:")
+			logger.InfoPretty("  1. Add a skill directory containing a SKILL.md (see 'grove-skills show grove-skill-guide')")
+			logger.InfoPretty("  2. Add it to [skills] use in a project's grove.toml")
+			logger.InfoPretty("  3. Run 'grove-skills validate' and 'grove-skills sync' in that project")
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&sample, "sample", false, "Also create an example skill")
+	return cmd
+}
@@ -7,7 +7,6 @@ import (
 	"regexp"
 	"strings"
 
-	"github.com/grovetools/core/pkg/workspace"
 	"github.com/spf13/cobra"
 )
 
@@ -44,7 +43,7 @@ Scopes:
   ecosystem  - Target CLAUDE.md in the ecosystem root
   global     - Target CLAUDE.md in the user's home directory`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cwd, err := os.Getwd()
+			cwd, err := workingDir()
 			if err != nil {
 				return fmt.Errorf("could not get current directory: %w", err)
 			}
@@ -55,7 +54,7 @@ Scopes:
 			case "project":
 				targetDir = cwd
 			case "ecosystem":
-				node, err := workspace.GetProjectByPath(cwd)
+				node, err := GetService().ResolveNode(cwd)
 				if err != nil {
 					return fmt.Errorf("could not resolve ecosystem: %w", err)
 				}
@@ -0,0 +1,17 @@
+package cmd
+
+import "encoding/json"
+
+// jsonSchemaVersion is the schema version of the envelope wrapping every
+// command's --json output. Bump it when a field is removed or changes meaning;
+// adding fields is not a breaking change.
+const jsonSchemaVersion = 1
+
+// marshalEnvelope encodes payload as indented JSON of the form
+// {"schemaVersion": N, "<key>": payload}.
+func marshalEnvelope(key string, payload any) ([]byte, error) {
+	return json.MarshalIndent(map[string]any{
+		"schemaVersion": jsonSchemaVersion,
+		key:             payload,
+	}, "", "  ")
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+
+	"github.com/grovetools/skills/pkg/skills"
+	"github.com/spf13/cobra"
+)
+
+func newSkillsOpenCmd() *cobra.Command {
+	var scope, provider string
+	var source bool
+
+	cmd := &cobra.Command{
+		Use:   "open <name>",
+		Short: "Open an installed skill's directory in the file manager",
+		Long: `Open the directory of an installed skill in the system file manager.
+
+By default the installed copy is opened, resolved from --scope and --provider
+the same way as 'remove'. Use --source to open the authoritative source
+directory instead (the one you should edit). Builtin skills have no source
+directory on disk.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeSkillNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateScopeAndProvider(scope, provider); err != nil {
+				return err
+			}
+			name := args[0]
+
+			var dir string
+			if source {
+				srcDir, err := resolveSkillSourceDir(name)
+				if err != nil {
+					return err
+				}
+				dir = srcDir
+			} else {
+				basePath, err := getInstallPath(provider, scope)
+				if err != nil {
+					return err
+				}
+				dir = filepath.Join(basePath, name)
+				if _, err := os.Stat(dir); os.IsNotExist(err) {
+					return fmt.Errorf("skill '%s' is not installed at %s (scope=%s, provider=%s)", name, dir, scope, provider)
+				}
+			}
+
+			if err := openInFileManager(dir); err != nil {
+				return fmt.Errorf("failed to open %s: %w", dir, err)
+			}
+			fmt.Println(dir)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&scope, "scope", "project", "Scope to open from ('project', 'user', 'ecosystem', 'repo-root', or 'admin' for codex).")
+	cmd.Flags().StringVar(&provider, "provider", "claude", "Agent provider ('claude', 'codex', 'opencode'; aliases 'cc', 'cx', 'oc').")
+	cmd.Flags().BoolVar(&source, "source", false, "Open the skill's source directory instead of the installed copy")
+	return cmd
+}
+
+// resolveSkillSourceDir returns the on-disk source directory for a skill,
+// using the same precedence as 'show'.
+func resolveSkillSourceDir(name string) (string, error) {
+	svc := GetService()
+
+	cwd, err := workingDir()
+	if err != nil {
+		return "", fmt.Errorf("could not get current directory: %w", err)
+	}
+
+	node, err := svc.ResolveNode(cwd)
+	if err != nil {
+		node = nil
+	}
+	if svc == nil && node != nil {
+		if svc, err = skills.NewServiceForNode(node); err != nil {
+			svc = nil
+		}
+	}
+
+	loaded, err := skills.LoadSkillBypassingAccessWithService(svc, node, name)
+	if err != nil {
+		return "", err
+	}
+	if loaded.SourceType == skills.SourceTypeBuiltin {
+		return "", fmt.Errorf("skill '%s' is builtin and has no source directory on disk", name)
+	}
+	return loaded.PhysicalPath, nil
+}
+
+// openInFileManager reveals dir using the platform's default file manager.
+func openInFileManager(dir string) error {
+	opener := "xdg-open"
+	switch runtime.GOOS {
+	case "darwin":
+		opener = "open"
+	case "windows":
+		opener = "explorer"
+	}
+	return exec.Command(opener, dir).Start() //nolint:gosec // G204: dir is a resolved skill path
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grovetools/core/logging"
+	"github.com/grovetools/skills/pkg/skills"
+	"github.com/spf13/cobra"
+)
+
+func newSkillsRepairCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repair [name...]",
+		Short: "Restore missing files in partially installed skills",
+		Long: `Repair installed copies of the skills configured in grove.toml.
+
+Each installed skill directory (in the repository and its worktrees) is
+compared against the skill's source. Files missing from the installed copy
+are re-copied; if SKILL.md itself is missing the skill is reinstalled from
+scratch. Existing files are never modified, and skills that are not installed
+are left for 'sync'.
+
+With no arguments every configured skill is checked; otherwise only the
+named skills are.`,
+		ValidArgsFunction: completeSkillNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := logging.NewPrettyLogger()
+			svc := GetService()
+
+			cwd, err := workingDir()
+			if err != nil {
+				return fmt.Errorf("could not get current directory: %w", err)
+			}
+
+			node, err := svc.ResolveNode(cwd)
+			if err != nil {
+				return fmt.Errorf("repair requires a workspace context: %w", err)
+			}
+			if svc == nil {
+				svc, err = skills.NewServiceForNode(node)
+				if err != nil {
+					return fmt.Errorf("could not create service: %w", err)
+				}
+			}
+
+			results, err := skills.RepairWorkspace(svc, node, args)
+			for _, r := range results {
+				if r.Reinstalled {
+					logger.Success(fmt.Sprintf("Reinstalled '%s' (SKILL.md was missing)", r.Skill))
+				} else {
+					logger.Success(fmt.Sprintf("Restored %d file(s) in '%s': %s", len(r.Restored), r.Skill, strings.Join(r.Restored, ", ")))
+				}
+				logger.Path("  at", r.Path)
+			}
+			if err != nil {
+				return err
+			}
+			if len(results) == 0 {
+				logger.InfoPretty("All installed skills are complete.")
+			}
+			return nil
+		},
+	}
+	return cmd
+}
@@ -1,14 +1,26 @@
 package cmd
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/charmbracelet/lipgloss"
 	"github.com/grovetools/core/cli"
 	coreconfig "github.com/grovetools/core/config"
+	"github.com/grovetools/core/git"
 	"github.com/grovetools/core/logging"
 	"github.com/grovetools/core/pkg/workspace"
+	"github.com/grovetools/core/tui/theme"
 	"github.com/grovetools/skills/pkg/service"
+	"github.com/grovetools/skills/pkg/skills"
+	"github.com/muesli/termenv"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -17,15 +29,75 @@ import (
 // It may be nil for commands that don't require workspace services.
 var svc *service.Service
 
+// contextDir is the absolute --context directory, or "" to use the process
+// working directory. Read it through workingDir and resolvePath.
+var contextDir string
+
+// logOutput is where the pretty and unified loggers write. configureColor
+// swaps in an icon-stripping writer for plain output; commands that silence
+// the loggers restore it afterwards.
+var logOutput io.Writer = os.Stdout
+
+// cancelTimeout releases the --timeout context once the command finishes.
+var cancelTimeout context.CancelFunc = func() {}
+
 // Initialize creates and returns the root command with all subcommands.
 // The service is initialized lazily via PersistentPreRunE when commands are executed.
 func Initialize() (*cobra.Command, error) {
 	rootCmd := cli.NewStandardCommand("grove-skills", "Agent Skill Integrations")
+	rootCmd.Long = `Agent Skill Integrations
+
+Exit codes:
+  0 - Success
+  1 - Generic error (bad flags, I/O or config failures)
+  2 - Validation failure (validate found invalid skills, sync --report-drift
+      found modified or extra skills)
+  3 - Partial failure: a batch sync failed for some skills or workspaces
+      but not all
+  4 - Not found: a requested skill or installed copy does not exist`
+
+	var noColor, embeddedOnly, noFollowSymlinks bool
+	var timeout time.Duration
+	var notebook, contextFlag string
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable colorized output")
+	rootCmd.PersistentFlags().BoolVar(&embeddedOnly, "embedded-only", false, "Use only the builtin skills embedded in the binary; skip config, workspace discovery and user/notebook sources")
+	rootCmd.PersistentFlags().BoolVar(&noFollowSymlinks, "no-follow-symlinks", false, "Ignore symlinked directories when discovering user, notebook and playbook skills")
+	rootCmd.PersistentFlags().StringVar(&notebook, "notebook", "", "Resolve notebook skills from this notebook definition instead of the one config selects")
+	rootCmd.PersistentFlags().StringVar(&contextFlag, "context", "", "Resolve workspaces and relative paths as if run from this directory")
+	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 0, "Abort git operations and skill writes after this duration (e.g. 30s); 0 disables")
 
 	// PersistentPreRunE initializes the shared service for all commands
 	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		configureColor(noColor)
+		if err := setContextDir(contextFlag); err != nil {
+			return err
+		}
+
+		if cmd.Name() == completeSkillsCmdName {
+			svc = &service.Service{EmbeddedOnly: embeddedOnly, NoFollowSymlinks: noFollowSymlinks}
+			return nil
+		}
+
+		if timeout > 0 {
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			cmd.SetContext(ctx)
+			cancelTimeout = cancel
+		}
+
 		logger := logging.NewLogger("grove-skills")
 
+		// Embedded-only runs must not depend on the host: no config, no
+		// discovery, just the builtin skills.
+		if embeddedOnly {
+			var err error
+			svc, err = service.NewWithContext(cmd.Context(), workspace.NewProvider(&workspace.DiscoveryResult{}), &coreconfig.Config{}, logger)
+			if err != nil {
+				return fmt.Errorf("failed to initialize service: %w", err)
+			}
+			svc.EmbeddedOnly = true
+			return nil
+		}
+
 		// Load configuration (best effort - we can proceed without it)
 		cfg, err := coreconfig.LoadDefault()
 		if err != nil {
@@ -33,6 +105,16 @@ func Initialize() (*cobra.Command, error) {
 			logger.Debugf("could not load grove config, proceeding with defaults: %v", err)
 		}
 
+		if err := applyConfiguredFlagDefaults(cmd, skills.LoadGlobalSkillsConfig(cfg)); err != nil {
+			return err
+		}
+
+		if notebook != "" {
+			if err := skills.ValidateNotebookName(cfg, notebook); err != nil {
+				return err
+			}
+		}
+
 		// Discover workspaces (best effort - we can proceed without full discovery)
 		discoveryLogger := logrus.New()
 		discoveryLogger.SetOutput(os.Stderr)
@@ -40,31 +122,49 @@ func Initialize() (*cobra.Command, error) {
 		discoveryService := workspace.NewDiscoveryService(discoveryLogger)
 		result, err := discoveryService.DiscoverAll()
 		if err != nil {
-			// Non-fatal: we can still function without workspace discovery
-			logger.Debugf("workspace discovery failed, notebook skills will not be available: %v", err)
-			result = &workspace.DiscoveryResult{}
+			// Non-fatal: fall back to the project containing the cwd so at
+			// least its notebook skills stay available.
+			result = localDiscoveryResult()
+			if len(result.Projects) > 0 {
+				logger.Warnf("workspace discovery failed, using only the current project %s: %v", result.Projects[0].Path, err)
+			} else {
+				logger.Debugf("workspace discovery failed, notebook skills will not be available: %v", err)
+			}
 		}
 		provider := workspace.NewProvider(result)
 
 		// Initialize the main service
-		svc, err = service.New(provider, cfg, logger)
+		svc, err = service.NewWithContext(cmd.Context(), provider, cfg, logger)
 		if err != nil {
 			return fmt.Errorf("failed to initialize service: %w", err)
 		}
+		svc.NoFollowSymlinks = noFollowSymlinks
+		svc.Notebook = notebook
 		return nil
 	}
 
 	// Add commands directly to root (no "skills" subcommand needed)
 	rootCmd.AddCommand(newVersionCmd())
+	rootCmd.AddCommand(newSkillsInitCmd())
 	rootCmd.AddCommand(newSkillsListCmd())
 	rootCmd.AddCommand(newSkillsSyncCmd())
 	rootCmd.AddCommand(newSkillsRemoveCmd())
 	rootCmd.AddCommand(newSkillsTreeCmd())
 	rootCmd.AddCommand(newSkillsSearchCmd())
 	rootCmd.AddCommand(newSkillsShowCmd())
+	rootCmd.AddCommand(newSkillsInfoCmd())
+	rootCmd.AddCommand(newSkillsCatCmd())
+	rootCmd.AddCommand(newSkillsCompareCmd())
+	rootCmd.AddCommand(newSkillsOpenCmd())
+	rootCmd.AddCommand(newSkillsBundleCmd())
+	rootCmd.AddCommand(newSkillsDocsCmd())
+	rootCmd.AddCommand(newSkillsHistoryCmd())
+	rootCmd.AddCommand(newSkillsRepairCmd())
 	rootCmd.AddCommand(newSkillsIntegrateCmd())
 	rootCmd.AddCommand(newSkillsValidateCmd())
+	rootCmd.AddCommand(newSkillsVerifyNameCmd())
 	rootCmd.AddCommand(newTuiCmd())
+	rootCmd.AddCommand(newCompleteSkillsCmd())
 
 	// Keep "skills" as an alias for backwards compatibility
 	rootCmd.AddCommand(newSkillsCmd())
@@ -72,6 +172,151 @@ func Initialize() (*cobra.Command, error) {
 	return rootCmd, nil
 }
 
+// localDiscoveryResult builds a discovery result containing only the project
+// at the cwd's git root (or the cwd itself outside git). It is the fallback
+// when full discovery fails; the result is empty if no project is found.
+func localDiscoveryResult() *workspace.DiscoveryResult {
+	result := &workspace.DiscoveryResult{}
+	cwd, err := workingDir()
+	if err != nil {
+		return result
+	}
+	root := cwd
+	if gitRoot, err := git.GetGitRoot(cwd); err == nil {
+		root = gitRoot
+	}
+	node, err := workspace.GetProjectByPath(root)
+	if err != nil {
+		return result
+	}
+	result.Projects = []workspace.Project{{
+		Name:                node.Name,
+		Path:                node.Path,
+		ParentEcosystemPath: node.ParentEcosystemPath,
+		Workspaces: []workspace.DiscoveredWorkspace{{
+			Name:              node.Name,
+			Path:              node.Path,
+			Type:              workspace.WorkspaceTypePrimary,
+			ParentProjectPath: node.Path,
+		}},
+	}}
+	return result
+}
+
+// setContextDir validates the --context directory and records its absolute
+// path in contextDir. An empty dir clears it.
+func setContextDir(dir string) error {
+	if dir == "" {
+		contextDir = ""
+		return nil
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return fmt.Errorf("invalid --context %q: %w", dir, err)
+	}
+	info, err := os.Stat(abs)
+	if err != nil {
+		return fmt.Errorf("invalid --context %q: %w", dir, err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("invalid --context %q: not a directory", dir)
+	}
+	contextDir = abs
+	return nil
+}
+
+// workingDir returns the directory commands resolve workspaces from: the
+// --context directory when set, otherwise the process working directory.
+func workingDir() (string, error) {
+	if contextDir != "" {
+		return contextDir, nil
+	}
+	return os.Getwd()
+}
+
+// resolvePath resolves a relative path argument against the --context
+// directory. Without --context, and for absolute or empty paths, p is
+// returned unchanged.
+func resolvePath(p string) string {
+	if contextDir == "" || p == "" || filepath.IsAbs(p) {
+		return p
+	}
+	return filepath.Join(contextDir, p)
+}
+
+// configureColor strips ANSI styling and the loggers' icon prefixes from
+// pretty output when --no-color is set or when stdout/stderr is not a
+// terminal (pipes, CI logs).
+func configureColor(noColor bool) {
+	if noColor || !isTerminal(os.Stdout) || !isTerminal(os.Stderr) {
+		lipgloss.SetColorProfile(termenv.Ascii)
+		logOutput = &iconStripWriter{w: os.Stdout}
+		logging.SetGlobalOutput(logOutput)
+	}
+}
+
+// iconStripWriter drops a leading status icon (e.g. the success checkmark)
+// and its separating space from each write. The loggers emit every line's
+// icon at the start of a single write, so no buffering is needed.
+type iconStripWriter struct {
+	w io.Writer
+}
+
+func (s *iconStripWriter) Write(p []byte) (int, error) {
+	out := p
+	for _, icon := range []string{
+		theme.IconSuccess, theme.IconError, theme.IconWarning, theme.IconInfo,
+		theme.IconRunning, theme.IconPending, theme.IconBullet, theme.IconTree,
+	} {
+		if icon != "" && bytes.HasPrefix(out, []byte(icon+" ")) {
+			out = out[len(icon)+1:]
+			break
+		}
+	}
+	if _, err := s.w.Write(out); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// applyConfiguredFlagDefaults sets --scope and --provider from
+// skills.default_scope / skills.default_provider when the command has those
+// flags and the user did not pass them explicitly.
+func applyConfiguredFlagDefaults(cmd *cobra.Command, cfg *skills.SkillsConfig) error {
+	if cfg == nil {
+		return nil
+	}
+
+	if cfg.DefaultScope != "" {
+		if !slices.Contains(validScopes, cfg.DefaultScope) {
+			return fmt.Errorf("invalid skills.default_scope %q in grove config (valid: %s)", cfg.DefaultScope, strings.Join(validScopes, ", "))
+		}
+		if f := cmd.Flags().Lookup("scope"); f != nil && !f.Changed {
+			_ = f.Value.Set(cfg.DefaultScope)
+		}
+	}
+
+	if cfg.DefaultProvider != "" {
+		provider := skills.NormalizeProvider(cfg.DefaultProvider)
+		if !slices.Contains(validProviders, provider) {
+			return fmt.Errorf("invalid skills.default_provider %q in grove config (valid: %s)", cfg.DefaultProvider, strings.Join(validProviders, ", "))
+		}
+		if f := cmd.Flags().Lookup("provider"); f != nil && !f.Changed {
+			_ = f.Value.Set(provider)
+		}
+	}
+	return nil
+}
+
+// isTerminal reports whether f is attached to a character device.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
 // GetService returns the shared service instance.
 // It may return nil if the service has not been initialized yet.
 func GetService() *service.Service {
@@ -84,5 +329,6 @@ func Execute() error {
 	if err != nil {
 		return err
 	}
+	defer func() { cancelTimeout() }()
 	return cli.Execute(rootCmd)
 }
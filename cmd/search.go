@@ -1,14 +1,12 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"text/tabwriter"
 
-	"github.com/grovetools/core/pkg/workspace" // used by GetProjectByPath
 	"github.com/grovetools/skills/pkg/skills"
 	"github.com/spf13/cobra"
 )
@@ -48,12 +46,12 @@ Output modes:
 			query := strings.ToLower(args[0])
 			svc := GetService()
 
-			cwd, err := os.Getwd()
+			cwd, err := workingDir()
 			if err != nil {
 				return fmt.Errorf("could not get current directory: %w", err)
 			}
 
-			node, err := workspace.GetProjectByPath(cwd)
+			node, err := svc.ResolveNode(cwd)
 			if err != nil {
 				// Not in a workspace, but we can still search built-in skills
 				node = nil
@@ -71,19 +69,9 @@ Output modes:
 			sources := skills.ListSkillSources(svc, node)
 			var results []SearchResult
 
-			for name, src := range sources {
-				loadedSkill, loadErr := skills.LoadSkillFromSource(name, src)
-				if loadErr != nil {
-					continue
-				}
-
-				content := loadedSkill.Files["SKILL.md"]
-				if content == nil {
-					continue
-				}
-
-				meta, parseErr := skills.ParseSkillFrontmatter(content)
-				if parseErr != nil {
+			for _, src := range sources {
+				meta, err := skills.ReadSkillMetadata(src)
+				if err != nil {
 					continue
 				}
 
@@ -97,15 +85,15 @@ Output modes:
 				}
 
 				if matchReason != "" {
-					filePath := filepath.Join(loadedSkill.PhysicalPath, "SKILL.md")
-					if loadedSkill.SourceType == skills.SourceTypeBuiltin {
+					filePath := filepath.Join(src.Path, "SKILL.md")
+					if src.Type == skills.SourceTypeBuiltin {
 						filePath = "[READ-ONLY BUILTIN]"
 					}
 					results = append(results, SearchResult{
 						Name:        meta.Name,
 						Description: meta.Description,
 						Domain:      meta.Domain,
-						Source:      string(loadedSkill.SourceType),
+						Source:      string(src.Type),
 						FilePath:    filePath,
 						MatchReason: matchReason,
 					})
@@ -132,7 +120,7 @@ Output modes:
 			}
 
 			if jsonOutput {
-				out, err := json.MarshalIndent(results, "", "  ")
+				out, err := marshalEnvelope("results", results)
 				if err != nil {
 					return fmt.Errorf("failed to marshal JSON: %w", err)
 				}
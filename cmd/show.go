@@ -1,13 +1,12 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
-	"os"
 	"path/filepath"
 	"strings"
 
-	"github.com/grovetools/core/pkg/workspace" // used by GetProjectByPath
+	"github.com/grovetools/core/pkg/workspace"
+	"github.com/grovetools/skills/pkg/service"
 	"github.com/grovetools/skills/pkg/skills"
 	"github.com/spf13/cobra"
 )
@@ -18,13 +17,15 @@ type ShowResult struct {
 	Description string   `json:"description"`
 	Domain      string   `json:"domain,omitempty"`
 	Requires    []string `json:"requires,omitempty"`
+	Aliases     []string `json:"aliases,omitempty"`
+	Deprecated  string   `json:"deprecated,omitempty"`
 	Source      string   `json:"source"`
 	FilePath    string   `json:"file_path"`
 	Content     string   `json:"content"`
 }
 
 func newSkillsShowCmd() *cobra.Command {
-	var jsonOutput bool
+	var jsonOutput, explain bool
 
 	cmd := &cobra.Command{
 		Use:   "show <skill-name>",
@@ -41,18 +42,24 @@ The skill name can be:
 
 Output modes:
   --json    Output structured JSON with metadata and full content (recommended for agents)
-  (default) Human-readable format with metadata header and raw content`,
-		Args: cobra.ExactArgs(1),
+  (default) Human-readable format with metadata header and raw content
+
+Use --explain to print how the name resolves instead of the skill: every
+source tier checked in precedence order (lowest first), whether it defines the
+skill, and why the winning tier won. It works for names that don't resolve too,
+exiting with 4 after the trace. Combine with --json for a structured trace.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeSkillNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			skillName := args[0]
 			svc := GetService()
 
-			cwd, err := os.Getwd()
+			cwd, err := workingDir()
 			if err != nil {
 				return fmt.Errorf("could not get current directory: %w", err)
 			}
 
-			node, err := workspace.GetProjectByPath(cwd)
+			node, err := svc.ResolveNode(cwd)
 			if err != nil {
 				// Not in a workspace, but we can still show builtin/user skills
 				node = nil
@@ -67,6 +74,10 @@ Output modes:
 				}
 			}
 
+			if explain {
+				return explainSkillResolution(svc, node, skillName, jsonOutput)
+			}
+
 			loadedSkill, err := skills.LoadSkillBypassingAccessWithService(svc, node, skillName)
 			if err != nil {
 				return err
@@ -95,12 +106,14 @@ Output modes:
 					Description: meta.Description,
 					Domain:      meta.Domain,
 					Requires:    meta.Requires,
+					Aliases:     meta.Aliases,
+					Deprecated:  meta.Deprecated,
 					Source:      string(loadedSkill.SourceType),
 					FilePath:    filePath,
 					Content:     string(content),
 				}
 
-				out, err := json.MarshalIndent(result, "", "  ")
+				out, err := marshalEnvelope("skill", result)
 				if err != nil {
 					return fmt.Errorf("failed to marshal JSON: %w", err)
 				}
@@ -118,6 +131,12 @@ Output modes:
 			if len(meta.Requires) > 0 {
 				fmt.Printf("Requires:    %s\n", strings.Join(meta.Requires, ", "))
 			}
+			if len(meta.Aliases) > 0 {
+				fmt.Printf("Aliases:     %s\n", strings.Join(meta.Aliases, ", "))
+			}
+			if meta.Deprecated != "" {
+				fmt.Printf("Deprecated:  %s\n", meta.Deprecated)
+			}
 			fmt.Printf("Source:      %s\n", loadedSkill.SourceType)
 			fmt.Printf("Path:        %s\n", filePath)
 			fmt.Println()
@@ -129,6 +148,54 @@ Output modes:
 	}
 
 	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON (recommended for agents)")
+	cmd.Flags().BoolVar(&explain, "explain", false, "Print the resolution trace: each source checked and why the winner won")
 
 	return cmd
 }
+
+// explainSkillResolution prints the resolution trace of skillName. A name that
+// resolves nowhere still prints its trace, then fails with ExitNotFound.
+func explainSkillResolution(svc *service.Service, node *workspace.WorkspaceNode, skillName string, jsonOutput bool) error {
+	trace, err := skills.ExplainSkillResolution(svc, node, skillName)
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		out, err := marshalEnvelope("resolution", trace)
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(out))
+	} else {
+		fmt.Printf("Resolving '%s'", trace.Query)
+		if trace.Name != trace.Query {
+			fmt.Printf(" (as '%s')", trace.Name)
+		}
+		fmt.Println()
+		for _, step := range trace.Steps {
+			status := "not found"
+			if step.Found {
+				status = "found     " + step.Path
+			}
+			if step.Note != "" {
+				status += " [" + step.Note + "]"
+			}
+			marker := " "
+			if step.Tier == trace.Winner {
+				marker = "*"
+			}
+			fmt.Printf("  %s %-10s %s\n", marker, step.Tier, status)
+		}
+		if trace.Winner != "" {
+			fmt.Printf("Resolved from %s: %s\n", trace.Winner, trace.Reason)
+		} else {
+			fmt.Printf("Not resolved: %s\n", trace.Reason)
+		}
+	}
+
+	if trace.Winner == "" {
+		return withExitCode(ExitNotFound, &skills.SkillNotFoundError{SkillName: trace.Name})
+	}
+	return nil
+}
@@ -1,14 +1,18 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
+	coreconfig "github.com/grovetools/core/config"
 	"github.com/grovetools/core/git"
 	"github.com/grovetools/core/logging"
 	"github.com/grovetools/core/pkg/workspace"
@@ -39,7 +43,9 @@ func newSkillsCmd() *cobra.Command {
 }
 
 func newSkillsListCmd() *cobra.Command {
-	var showPath, grouped, ecosystem, allWorkspaces, jsonOutput bool
+	var showPath, grouped, ecosystem, allWorkspaces, jsonOutput, includeDisabled, providers, deprecatedOnly, validate, sourcePath, unused bool
+	var format, since, profile, changedVs, scope, provider, groupBy, usageLog string
+	var jobs int
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List available skills from all sources",
@@ -53,41 +59,168 @@ Skills are discovered from:
 
 Use --ecosystem to list skills from all workspaces in the current ecosystem.
 Use --all-workspaces to list skills from all registered workspaces.
+Use --group-by ecosystem for an overview of what each project in the current
+ecosystem would get from sync: per project, the skills its configuration
+(including inherited ecosystem and user config) resolves to and the source
+each resolves from. Projects whose skills fail to resolve show the error.
 
 The CONFIGURED column shows whether a skill is declared in grove.toml:
   - Yes: skill is in the [skills.use] array
   - No: skill is available but not configured
 
-Skills from other workspaces can be referenced as "workspace:skill-name" in grove.toml.`,
+Skills from other workspaces can be referenced as "workspace:skill-name" in grove.toml.
+
+Skills whose SKILL.md sets "disabled: true" are hidden. Use --include-disabled
+to show them, annotated with "(disabled)". Skills whose SKILL.md sets a
+"deprecated" message are annotated with "(deprecated)"; use --deprecated to
+list only those, together with the deprecation message.
+
+With --json, output is wrapped in an envelope carrying a schema version,
+e.g. {"schemaVersion": 1, "skills": [...]}, so parsers can detect changes.
+Each skill has its "name", "source" and "path" (always included, whatever the
+--format), sorted by name; --ecosystem and --all-workspaces add workspace
+fields.
+Add --validate to also check each skill's SKILL.md and report a "valid"
+boolean and an "errors" array per skill; skills that fail to parse are
+reported as invalid rather than omitted. Use --jobs to bound how many skills
+are validated in parallel.
+
+Use --since <when> to list only skills with a file modified after a duration
+(e.g. 24h, 7d) or date (YYYY-MM-DD), most recently modified first. Builtin
+skills are never included.
+
+Use --unused --usage-log <file> to list only the skills that never appear in an
+agent's skill usage log, as candidates for removal. The log is either a JSON
+array of skill names or plain text with one name per line; blank lines and
+lines starting with '#' are ignored, and workspace-qualified names match by
+skill name.
+
+Use --providers to show how many skills are installed for each provider in
+each scope (user, project, repo-root, ecosystem). Scopes that can't be resolved
+from the current directory are shown as "-".
+
+Use --format to choose the output layout:
+  - table: skill, configured and source columns (default)
+  - wide:  adds version (content digest), description and path
+  - name:  bare skill names, one per line, for piping into other commands
+--path is kept as an alias for --format wide.
+
+Use --source-path to debug where skills resolve from: it prints every directory
+scanned, by tier (user, notebook, ecosystem, project, playbook), then each
+skill's tier, the root it was found under and its symlink-resolved directory.
+
+Use --profile <name> to list only the source tiers selected by a
+[skills.profiles.<name>] entry in config (see 'sync --help').
+
+Use --changed-vs <provider> to compare the skills installed for --provider
+(default claude) with another provider in the same --scope (default project):
+skills installed for only one of them, and skills whose files differ.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if !slices.Contains(listFormats, format) {
+				return fmt.Errorf("invalid --format %q (valid: %s)", format, strings.Join(listFormats, ", "))
+			}
+			if showPath && !cmd.Flags().Changed("format") {
+				format = listFormatWide
+			}
+			var cutoff time.Time
+			if since != "" {
+				if allWorkspaces || ecosystem {
+					return fmt.Errorf("--since cannot be combined with --ecosystem or --all-workspaces")
+				}
+				t, err := parseSince(since)
+				if err != nil {
+					return err
+				}
+				cutoff = t
+			}
+
+			if validate && !jsonOutput {
+				return fmt.Errorf("--validate requires --json")
+			}
+			if jsonOutput && (grouped || sourcePath || deprecatedOnly) {
+				return fmt.Errorf("--json cannot be combined with --grouped, --source-path or --deprecated")
+			}
+			if unused != (usageLog != "") {
+				return fmt.Errorf("--unused and --usage-log must be used together")
+			}
+			var used map[string]bool
+			if unused {
+				if allWorkspaces || ecosystem || groupBy != "" {
+					return fmt.Errorf("--unused cannot be combined with --ecosystem, --all-workspaces or --group-by")
+				}
+				var err error
+				if used, err = skills.ReadUsageLog(resolvePath(usageLog)); err != nil {
+					return err
+				}
+			}
+			if providers {
+				return listProviderCounts(jsonOutput)
+			}
+			if changedVs != "" {
+				return listProviderChanges(provider, changedVs, scope, jsonOutput)
+			}
+			if cmd.Flags().Changed("scope") || cmd.Flags().Changed("provider") {
+				return fmt.Errorf("--scope and --provider require --changed-vs")
+			}
+			if groupBy != "" && groupBy != "ecosystem" {
+				return fmt.Errorf("invalid --group-by %q (valid: ecosystem)", groupBy)
+			}
+			if groupBy != "" && allWorkspaces {
+				return fmt.Errorf("--group-by cannot be combined with --all-workspaces")
+			}
+
 			svc := GetService()
 
 			// Get current workspace context
-			cwd, err := os.Getwd()
+			cwd, err := workingDir()
 			if err != nil {
 				return fmt.Errorf("could not get current directory: %w", err)
 			}
 
-			node, err := workspace.GetProjectByPath(cwd)
+			node, err := svc.ResolveNode(cwd)
 			if err != nil && !allWorkspaces {
+				if profile != "" {
+					return fmt.Errorf("--profile requires a workspace context: %w", err)
+				}
+				if groupBy != "" {
+					return fmt.Errorf("--group-by requires a workspace context: %w", err)
+				}
+				if unused {
+					return fmt.Errorf("--unused requires a workspace context: %w", err)
+				}
 				// Fall back to old behavior if not in a workspace
-				return listSkillsLegacy(svc, showPath)
+				return listSkillsLegacy(svc, format, jsonOutput, validate, jobs)
 			}
 
 			// Use the new multi-source discovery
 			if svc == nil && node != nil {
 				svc, err = skills.NewServiceForNode(node)
 				if err != nil {
-					return listSkillsLegacy(nil, showPath)
+					return listSkillsLegacy(nil, format, jsonOutput, validate, jobs)
 				}
 			}
 
+			if groupBy != "" {
+				return listSkillsByProject(svc, node, jsonOutput)
+			}
+
 			// Handle --all-workspaces and --ecosystem flags
 			if allWorkspaces || ecosystem {
-				return listWorkspaceSkills(svc, node, allWorkspaces, jsonOutput, showPath)
+				if profile != "" {
+					return fmt.Errorf("--profile cannot be combined with --ecosystem or --all-workspaces")
+				}
+				return listWorkspaceSkills(svc, node, allWorkspaces, jsonOutput, validate, jobs, format)
+			}
+
+			var excludeSources []string
+			if profile != "" {
+				excludeSources, err = resolveProfileExcludes(svc, node, profile)
+				if err != nil {
+					return err
+				}
 			}
 
-			sources := skills.ListSkillSources(svc, node)
+			sources := skills.ListSkillSourcesWithOptions(svc, node, skills.DiscoveryOptions{IncludeDisabled: includeDisabled, ExcludeSources: excludeSources})
 			if len(sources) == 0 {
 				ulog.Info("No skills found").
 					Pretty("No skills found.").
@@ -95,6 +228,8 @@ Skills from other workspaces can be referenced as "workspace:skill-name" in grov
 				return nil
 			}
 
+			warnDuplicateSkills(skills.FindDuplicateSkills(svc, node), logging.NewPrettyLogger().WithWriter(os.Stderr))
+
 			// Load skills configuration to check which skills are configured
 			skillsCfg, _ := skills.LoadSkillsConfig(svc.Config, node)
 			configuredMap := make(map[string]bool)
@@ -119,21 +254,76 @@ Skills from other workspaces can be referenced as "workspace:skill-name" in grov
 			}
 			sort.Strings(names)
 
+			if since != "" {
+				names = skillsModifiedSince(sources, names, cutoff)
+			}
+			if unused {
+				names = slices.DeleteFunc(names, func(name string) bool { return used[name] })
+			}
+
+			if jsonOutput {
+				return listSkillsJSON(names, sources, validate, jobs)
+			}
+
+			if sourcePath {
+				return listSkillSourcePaths(svc, node, sources, names)
+			}
+
 			// Grouped output mode
 			if grouped {
 				return listSkillsGrouped(svc, sources, names)
 			}
 
+			metas := make(map[string]*skills.SkillMetadata, len(names))
+			for _, name := range names {
+				if meta, err := skills.ReadSkillMetadata(sources[name]); err == nil {
+					metas[name] = meta
+				}
+			}
+
+			if deprecatedOnly {
+				return listDeprecatedSkills(names, metas)
+			}
+
+			displayName := func(name string) string {
+				meta := metas[name]
+				if meta == nil {
+					return name
+				}
+				if includeDisabled && meta.Disabled {
+					name += " (disabled)"
+				}
+				if meta.Deprecated != "" {
+					name += " (deprecated)"
+				}
+				return name
+			}
+
+			if format == listFormatName {
+				for _, name := range names {
+					fmt.Println(name)
+				}
+				return nil
+			}
+
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-			if showPath {
-				_, _ = fmt.Fprintln(w, "SKILL\tCONFIGURED\tSOURCE\tPATH")
+			if format == listFormatWide {
+				_, _ = fmt.Fprintln(w, "SKILL\tCONFIGURED\tSOURCE\tVERSION\tDESCRIPTION\tPATH")
 				for _, name := range names {
 					src := sources[name]
 					conf := "No"
 					if configuredMap[name] {
 						conf = "Yes"
 					}
-					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", name, conf, src.Type, src.Path)
+					desc := ""
+					if meta := metas[name]; meta != nil {
+						desc = truncateDescription(meta.Description)
+					}
+					version := "-"
+					if digest, err := skills.SourceDigest(src); err == nil {
+						version = digest[:12]
+					}
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", displayName(name), conf, src.Type, version, desc, src.Path)
 				}
 			} else {
 				_, _ = fmt.Fprintln(w, "SKILL\tCONFIGURED\tSOURCE")
@@ -142,21 +332,310 @@ Skills from other workspaces can be referenced as "workspace:skill-name" in grov
 					if configuredMap[name] {
 						conf = "Yes"
 					}
-					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", name, conf, sources[name].Type)
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", displayName(name), conf, sources[name].Type)
 				}
 			}
 			_ = w.Flush()
 			return nil
 		},
 	}
-	cmd.Flags().BoolVar(&showPath, "path", false, "Show the full path to each skill")
+	cmd.Flags().StringVar(&format, "format", listFormatTable, "Output format: 'table', 'wide' or 'name'")
+	cmd.Flags().BoolVar(&showPath, "path", false, "Alias for --format wide")
+	cmd.Flags().BoolVar(&sourcePath, "source-path", false, "Show the tier, root and resolved source directory of each skill")
 	cmd.Flags().BoolVar(&grouped, "grouped", false, "Group skills by domain")
+	cmd.Flags().StringVar(&groupBy, "group-by", "", "Show each ecosystem project's resolved skills ('ecosystem')")
 	cmd.Flags().BoolVar(&ecosystem, "ecosystem", false, "List skills from all workspaces in the ecosystem")
 	cmd.Flags().BoolVar(&allWorkspaces, "all-workspaces", false, "List skills from all registered workspaces")
 	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+	cmd.Flags().BoolVar(&validate, "validate", false, "With --json, validate each skill and report 'valid' and 'errors'")
+	cmd.Flags().IntVar(&jobs, "jobs", skills.DefaultJobs(), "Number of skills to validate in parallel with --validate")
+	cmd.Flags().BoolVar(&includeDisabled, "include-disabled", false, "Include skills marked disabled in frontmatter")
+	cmd.Flags().StringVar(&since, "since", "", "Only list skills modified within a duration (e.g. 24h, 7d) or since a date")
+	cmd.Flags().BoolVar(&unused, "unused", false, "List only skills that never appear in --usage-log")
+	cmd.Flags().StringVar(&usageLog, "usage-log", "", "Skill usage log (JSON array or one name per line) for --unused")
+	cmd.Flags().BoolVar(&providers, "providers", false, "Show installed skill counts per provider and scope")
+	cmd.Flags().BoolVar(&deprecatedOnly, "deprecated", false, "List only deprecated skills with their deprecation message")
+	cmd.Flags().StringVar(&profile, "profile", "", "Only list tiers selected by a profile from [skills.profiles]")
+	cmd.Flags().StringVar(&changedVs, "changed-vs", "", "Compare installed skills of --provider against this provider")
+	cmd.Flags().StringVar(&scope, "scope", "project", "Scope compared by --changed-vs ('project', 'user', 'ecosystem', 'repo-root')")
+	cmd.Flags().StringVar(&provider, "provider", "claude", "Provider compared by --changed-vs")
 	return cmd
 }
 
+// Output layouts accepted by list --format.
+const (
+	listFormatTable = "table"
+	listFormatWide  = "wide"
+	listFormatName  = "name"
+)
+
+var listFormats = []string{listFormatTable, listFormatWide, listFormatName}
+
+// skillsModifiedSince returns the names whose newest file is after cutoff,
+// most recently modified first.
+func skillsModifiedSince(sources map[string]skills.SkillSource, names []string, cutoff time.Time) []string {
+	modTimes := make(map[string]time.Time, len(names))
+	var recent []string
+	for _, name := range names {
+		mt, err := skills.SkillModTime(sources[name])
+		if err != nil || !mt.After(cutoff) {
+			continue
+		}
+		modTimes[name] = mt
+		recent = append(recent, name)
+	}
+	sort.SliceStable(recent, func(i, j int) bool { return modTimes[recent[i]].After(modTimes[recent[j]]) })
+	return recent
+}
+
+// listSkillSourcePaths prints the directories scanned for node's skills and,
+// for each skill, the tier and root it resolved from and its real path.
+func listSkillSourcePaths(svc *service.Service, node *workspace.WorkspaceNode, sources map[string]skills.SkillSource, names []string) error {
+	roots := skills.SkillSourceRoots(svc, node)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "TIER\tSCANNED DIRECTORY")
+	for _, root := range roots {
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", root.Tier, root.Dir)
+	}
+	_ = w.Flush()
+	fmt.Println()
+
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "SKILL\tTIER\tROOT\tSOURCE PATH")
+	for _, name := range names {
+		root, resolved := skills.SourceRootFor(roots, sources[name])
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", name, dashIfEmpty(root.Tier), dashIfEmpty(root.Dir), dashIfEmpty(resolved))
+	}
+	return w.Flush()
+}
+
+// truncateDescription shortens a description to fit a table column.
+func truncateDescription(desc string) string {
+	if len(desc) > 60 {
+		return desc[:57] + "..."
+	}
+	return desc
+}
+
+// listDeprecatedSkills prints the deprecated subset of names with their messages.
+func listDeprecatedSkills(names []string, metas map[string]*skills.SkillMetadata) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	found := false
+	for _, name := range names {
+		meta := metas[name]
+		if meta == nil || meta.Deprecated == "" {
+			continue
+		}
+		if !found {
+			_, _ = fmt.Fprintln(w, "SKILL\tDEPRECATED")
+			found = true
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", name, meta.Deprecated)
+	}
+	if !found {
+		fmt.Println("No deprecated skills found.")
+		return nil
+	}
+	return w.Flush()
+}
+
+// listProviderCounts prints a provider x scope matrix of installed skill counts.
+// A count of -1 (shown as "-") means the scope could not be resolved here.
+func listProviderCounts(jsonOutput bool) error {
+	providerNames := validProviders
+	scopes := []string{"user", "project", "repo-root", "ecosystem"}
+
+	counts := make(map[string]map[string]int, len(providerNames))
+	for _, provider := range providerNames {
+		counts[provider] = make(map[string]int, len(scopes))
+		for _, scope := range scopes {
+			counts[provider][scope] = -1
+			basePath, err := getInstallPath(provider, scope)
+			if err != nil {
+				continue
+			}
+			installed, err := skills.ListInstalled(basePath)
+			if err != nil {
+				continue
+			}
+			counts[provider][scope] = len(installed)
+		}
+	}
+
+	if jsonOutput {
+		out, err := marshalEnvelope("providers", counts)
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "PROVIDER\tUSER\tPROJECT\tREPO-ROOT\tECOSYSTEM")
+	for _, provider := range providerNames {
+		row := []string{provider}
+		for _, scope := range scopes {
+			if n := counts[provider][scope]; n >= 0 {
+				row = append(row, fmt.Sprintf("%d", n))
+			} else {
+				row = append(row, "-")
+			}
+		}
+		_, _ = fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	_ = w.Flush()
+	return nil
+}
+
+// listProviderChanges compares the skills installed for two providers in one
+// scope and prints the skills that differ.
+func listProviderChanges(provider, other, scope string, jsonOutput bool) error {
+	if err := validateScopeAndProvider(scope, provider); err != nil {
+		return err
+	}
+	if err := validateScopeAndProvider(scope, other); err != nil {
+		return err
+	}
+	provider, other = skills.NormalizeProvider(provider), skills.NormalizeProvider(other)
+	if provider == other {
+		return fmt.Errorf("--changed-vs must name a different provider than --provider (%s)", provider)
+	}
+
+	leftPath, err := getInstallPath(provider, scope)
+	if err != nil {
+		return err
+	}
+	rightPath, err := getInstallPath(other, scope)
+	if err != nil {
+		return err
+	}
+	cmp, err := skills.CompareInstalled(leftPath, rightPath)
+	if err != nil {
+		return fmt.Errorf("failed to compare installed skills: %w", err)
+	}
+
+	if jsonOutput {
+		out, err := marshalEnvelope("comparison", map[string]any{
+			"scope":     scope,
+			"left":      provider,
+			"right":     other,
+			"onlyLeft":  cmp.OnlyLeft,
+			"onlyRight": cmp.OnlyRight,
+			"changed":   cmp.Changed,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+
+	if cmp.Empty() {
+		ulog.Info("Providers in sync").
+			Pretty(fmt.Sprintf("%s and %s have identical %s skills.", provider, other, scope)).
+			Emit()
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "SKILL\tSTATUS")
+	for _, name := range cmp.OnlyLeft {
+		_, _ = fmt.Fprintf(w, "%s\tonly %s\n", name, provider)
+	}
+	for _, name := range cmp.OnlyRight {
+		_, _ = fmt.Fprintf(w, "%s\tonly %s\n", name, other)
+	}
+	for _, c := range cmp.Changed {
+		_, _ = fmt.Fprintf(w, "%s\tdiffers (%s)\n", c.Skill, strings.Join(c.Files, ", "))
+	}
+	return w.Flush()
+}
+
+// projectSkills is one project's entry in list --group-by ecosystem --json.
+type projectSkills struct {
+	Project string          `json:"project"`
+	Path    string          `json:"path"`
+	Skills  []resolvedEntry `json:"skills"`
+	Error   string          `json:"error,omitempty"`
+}
+
+// resolvedEntry is a skill a project resolves, with where it resolves from.
+type resolvedEntry struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+	Path   string `json:"path"`
+}
+
+// listSkillsByProject prints, for every project in the current ecosystem, the
+// skills its configuration resolves to, as sync would install them.
+func listSkillsByProject(svc *service.Service, node *workspace.WorkspaceNode, jsonOutput bool) error {
+	nodes, err := ecosystemNodes(node)
+	if err != nil {
+		return err
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+
+	projects := make([]projectSkills, 0, len(nodes))
+	for _, n := range nodes {
+		entry := projectSkills{Project: n.Name, Path: n.Path, Skills: []resolvedEntry{}}
+		nodeSvc := svc
+		if nodeSvc == nil {
+			if nodeSvc, err = skills.NewServiceForNode(n); err != nil {
+				entry.Error = err.Error()
+				projects = append(projects, entry)
+				continue
+			}
+		}
+
+		skillsCfg, err := skills.LoadSkillsConfig(nodeSvc.Config, n)
+		if skillsCfg == nil {
+			skillsCfg = &skills.SkillsConfig{}
+		}
+		var resolved map[string]skills.ResolvedSkill
+		if err == nil {
+			resolved, err = skills.ResolveConfiguredSkills(nodeSvc, n, skillsCfg)
+		}
+		if err != nil {
+			entry.Error = err.Error()
+		}
+		for name, r := range resolved {
+			entry.Skills = append(entry.Skills, resolvedEntry{Name: name, Source: string(r.SourceType), Path: r.PhysicalPath})
+		}
+		sort.Slice(entry.Skills, func(i, j int) bool { return entry.Skills[i].Name < entry.Skills[j].Name })
+		projects = append(projects, entry)
+	}
+
+	if jsonOutput {
+		out, err := marshalEnvelope("projects", projects)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+
+	for i, p := range projects {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("## %s\n", p.Project)
+		if p.Error != "" {
+			fmt.Printf("  error: %s\n", p.Error)
+			continue
+		}
+		if len(p.Skills) == 0 {
+			fmt.Println("  (no skills configured)")
+			continue
+		}
+		for _, sk := range p.Skills {
+			fmt.Printf("  %s (%s)\n", sk.Name, sk.Source)
+		}
+	}
+	return nil
+}
+
 // listSkillsGrouped displays skills organized by their domain field.
 func listSkillsGrouped(svc *service.Service, sources map[string]skills.SkillSource, names []string) error {
 	// Map of domain -> list of skills
@@ -166,17 +645,9 @@ func listSkillsGrouped(svc *service.Service, sources map[string]skills.SkillSour
 		src := sources[name]
 		domain := "uncategorized"
 
-		// Read skill content to get domain
-		var content []byte
-		if loadedSkill, err := skills.LoadSkillFromSource(name, src); err == nil {
-			content = loadedSkill.Files["SKILL.md"]
-		}
-
-		if content != nil {
-			meta, err := skills.ParseSkillFrontmatter(content)
-			if err == nil && meta.Domain != "" {
-				domain = meta.Domain
-			}
+		// Only the frontmatter is needed for the domain
+		if meta, err := skills.ReadSkillMetadata(src); err == nil && meta.Domain != "" {
+			domain = meta.Domain
 		}
 
 		domainSkills[domain] = append(domainSkills[domain], name)
@@ -204,11 +675,59 @@ func listSkillsGrouped(svc *service.Service, sources map[string]skills.SkillSour
 	return nil
 }
 
-// listSkillsLegacy falls back to the old listing behavior when not in a workspace
-func listSkillsLegacy(svc *service.Service, showPath bool) error {
-	allSkills, sources, err := skills.ListSkillsWithService(svc)
+// listedSkill is one entry of list --json outside --ecosystem and
+// --all-workspaces.
+type listedSkill struct {
+	Name   string   `json:"name"`
+	Source string   `json:"source"`
+	Path   string   `json:"path"`
+	Valid  *bool    `json:"valid,omitempty"`
+	Errors []string `json:"errors,omitempty"`
+}
+
+// listSkillsJSON prints the named skills, in the given order, as a JSON array
+// of name, source and path (regardless of --format), validating each one when
+// validate is set.
+func listSkillsJSON(names []string, sources map[string]skills.SkillSource, validate bool, jobs int) error {
+	var validation [][]string
+	if validate {
+		validation = skills.MapBounded(names, jobs, func(name string) []string {
+			return skills.ValidateSkillSource(sources[name], name)
+		})
+	}
+
+	output := make([]listedSkill, 0, len(names))
+	for i, name := range names {
+		src := sources[name]
+		entry := listedSkill{Name: name, Source: string(src.Type), Path: src.Path}
+		if validate {
+			entry.Errors = validation[i]
+			valid := len(entry.Errors) == 0
+			entry.Valid = &valid
+		}
+		output = append(output, entry)
+	}
+
+	out, err := marshalEnvelope("skills", output)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+// listSkillsLegacy falls back to the old listing behavior when not in a workspace
+func listSkillsLegacy(svc *service.Service, format string, jsonOutput, validate bool, jobs int) error {
+	// Discover once and derive the names from the sources so --json does
+	// not walk every skill directory a second time.
+	sources := skills.ListSkillSources(svc, nil)
+	allSkills := make([]string, 0, len(sources))
+	for name := range sources {
+		allSkills = append(allSkills, name)
+	}
+	sort.Strings(allSkills)
+	if jsonOutput {
+		return listSkillsJSON(allSkills, sources, validate, jobs)
 	}
 	if len(allSkills) == 0 {
 		ulog.Info("No skills found").
@@ -216,17 +735,23 @@ func listSkillsLegacy(svc *service.Service, showPath bool) error {
 			Emit()
 		return nil
 	}
+	if format == listFormatName {
+		for _, name := range allSkills {
+			fmt.Println(name)
+		}
+		return nil
+	}
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	_, _ = fmt.Fprintln(w, "SKILL\tSOURCE")
 	for _, name := range allSkills {
-		_, _ = fmt.Fprintf(w, "%s\t%s\n", name, sources[name])
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", name, sources[name].Type)
 	}
 	_ = w.Flush()
 	return nil
 }
 
 // listWorkspaceSkills lists skills from all workspaces (--ecosystem or --all-workspaces)
-func listWorkspaceSkills(svc *service.Service, node *workspace.WorkspaceNode, allWorkspaces bool, jsonOutput bool, showPath bool) error {
+func listWorkspaceSkills(svc *service.Service, node *workspace.WorkspaceNode, allWorkspaces, jsonOutput, validate bool, jobs int, format string) error {
 	var workspaceSkills []skills.WorkspaceSkill //nolint:prealloc // size unknown before branch
 	var err error
 
@@ -268,43 +793,68 @@ func listWorkspaceSkills(svc *service.Service, node *workspace.WorkspaceNode, al
 
 	if jsonOutput {
 		type skillOutput struct {
-			Name          string `json:"name"`
-			Workspace     string `json:"workspace"`
-			QualifiedName string `json:"qualified_name"`
-			Path          string `json:"path"`
-			Description   string `json:"description,omitempty"`
+			Name          string   `json:"name"`
+			Workspace     string   `json:"workspace"`
+			QualifiedName string   `json:"qualified_name"`
+			Path          string   `json:"path"`
+			Description   string   `json:"description,omitempty"`
+			Valid         *bool    `json:"valid,omitempty"`
+			Errors        []string `json:"errors,omitempty"`
 		}
 
-		var output []skillOutput
-		for _, s := range workspaceSkills {
-			output = append(output, skillOutput{
+		var validation [][]string
+		if validate {
+			validation = skills.MapBounded(workspaceSkills, jobs, func(s skills.WorkspaceSkill) []string {
+				src := skills.SkillSource{Path: s.Path}
+				if s.Workspace == "(builtin)" {
+					src, _ = skills.BuiltinSkillSource(s.Name)
+				}
+				return skills.ValidateSkillSource(src, s.Name)
+			})
+		}
+
+		output := make([]skillOutput, 0, len(workspaceSkills))
+		for i, s := range workspaceSkills {
+			entry := skillOutput{
 				Name:          s.Name,
 				Workspace:     s.Workspace,
 				QualifiedName: s.QualifiedName,
 				Path:          s.Path,
 				Description:   s.Description,
-			})
+			}
+			if validate {
+				entry.Errors = validation[i]
+				valid := len(entry.Errors) == 0
+				entry.Valid = &valid
+			}
+			output = append(output, entry)
 		}
 
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(output)
+		out, err := marshalEnvelope("skills", output)
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+
+	if format == listFormatName {
+		for _, s := range workspaceSkills {
+			fmt.Println(s.QualifiedName)
+		}
+		return nil
 	}
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	if showPath {
-		_, _ = fmt.Fprintln(w, "WORKSPACE\tSKILL\tPATH")
+	if format == listFormatWide {
+		_, _ = fmt.Fprintln(w, "WORKSPACE\tSKILL\tDESCRIPTION\tPATH")
 		for _, s := range workspaceSkills {
-			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", s.Workspace, s.Name, s.Path)
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", s.Workspace, s.Name, truncateDescription(s.Description), s.Path)
 		}
 	} else {
 		_, _ = fmt.Fprintln(w, "WORKSPACE\tSKILL\tDESCRIPTION")
 		for _, s := range workspaceSkills {
-			desc := s.Description
-			if len(desc) > 60 {
-				desc = desc[:57] + "..."
-			}
-			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", s.Workspace, s.Name, desc)
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", s.Workspace, s.Name, truncateDescription(s.Description))
 		}
 	}
 	_ = w.Flush()
@@ -312,7 +862,10 @@ func listWorkspaceSkills(svc *service.Service, node *workspace.WorkspaceNode, al
 }
 
 func newSkillsSyncCmd() *cobra.Command {
-	var prune, dryRun, allWorkspaces, ecosystem bool
+	var prune, dryRun, diff, allWorkspaces, ecosystem, merge, includeDisabled, stripComments, canonicalize, warnShadowed, hardlink, linkSource, exact, allowEmptyPrune, noCreateBase, readOnly, atomic, detectRenames, selfCheck, render, reportDrift, jsonOutput, summaryOnly bool
+	var since, reportPath, logFormat, dirMode, fileMode, profile, pruneScope string
+	var excludeSources, prunePaths []string
+	var providerMap map[string]string
 	cmd := &cobra.Command{
 		Use:   "sync",
 		Short: "Sync skills declared in grove.toml to provider directories",
@@ -327,20 +880,190 @@ Example grove.toml configuration:
   use = ["explain-with-analogy", "grove-maintainer"]
   providers = ["claude", "codex"]  # default: ["claude"]
 
+Set GROVE_SKILLS_MANIFEST to a manifest file to install exactly the skills it
+declares instead of those configured in grove.toml, e.g. in a container image:
+
+  # skills.toml (or skills.yml)
+  use = ["code-review", "explain-with-analogy"]
+  providers = ["claude"]
+
+The manifest accepts use, providers and dependencies; unknown fields, invalid
+names and skills that can't be found fail the sync.
+
 Use --dry-run to preview what would be synced without making changes.
+Combine --dry-run with --diff to also print a unified diff per skill between
+the installed files and what would be written, plus the skills --prune would
+remove. Nothing is written to disk.
 Use --prune to remove skills that are no longer declared in the configuration.
+A prune that would remove every installed skill (nothing configured, or the
+configured skills resolved to none) is skipped with a warning, since that is
+usually a broken grove.toml; pass --allow-empty-prune to prune to empty.
+Use --atomic when an agent may read the skills directory during a sync: each
+provider skills directory is rebuilt in a temporary directory next to it and
+then swapped in, so readers see either the old or the new set, never a mix. If
+any skill fails to install the directory is left as it was. A directory that
+can't be renamed (a mount point) is updated in place instead, with a warning.
+Use --merge to overwrite only the files each skill ships, keeping any extra
+files you added inside an installed skill directory. Without --merge every
+synced skill directory is wiped and rewritten. --merge does not affect
+--prune, which still removes whole undeclared skill directories.
+Sync creates each provider's skills directory (e.g. .claude/skills) when it is
+missing. Use --no-create-base to fail those skills instead, so skills are only
+installed for providers whose directory already exists.
+Skills marked "disabled: true" in frontmatter are skipped even when declared;
+use --include-disabled to sync them anyway.
+Use --since <git-ref> to only rewrite skills whose source directory changed
+between that ref and HEAD in the source repository (e.g. your notebook).
+Builtin skills are skipped in this mode.
+Use --strip-comments to remove HTML comments and collapse repeated blank lines
+in each installed SKILL.md body, reducing the tokens agents read. Frontmatter
+and fenced code blocks are left untouched. This changes the installed content,
+so it is off by default; source files are never modified.
+Use --render to append the markdown files listed in a skill's "includes"
+frontmatter (e.g. parts/*.md) to the installed SKILL.md, in order, so authors
+can keep long skills modular. A missing include fails that skill's sync.
+Use --canonicalize to rewrite each installed SKILL.md frontmatter in a canonical
+form: YAML, known fields in a fixed order, then other fields sorted by key,
+with consistent quoting and indentation. The body is kept exactly. Like
+--strip-comments it changes the installed content, so it is off by default.
+Use --exclude-source <tier> (repeatable) to ignore a discovery tier for this
+sync: builtin, user, notebook, ecosystem, project or playbook. Globs such as
+"note*" are accepted. The remaining tiers keep their normal precedence.
+Use --profile <name> to apply a source selection defined in config, e.g.
+
+  [skills.profiles.minimal]
+  sources = ["builtin", "user"]         # keep only these tiers
+  exclude_sources = []                  # then drop these
+
+It combines with --exclude-source.
+Use --warn-shadowed to print a warning when a synced skill hides a
+lower-precedence copy of the same name with different content (for example a
+user skill overriding an edited notebook skill).
+Use --dir-mode and --file-mode (octal, e.g. 0750 / 0640) to set the permissions
+of installed skill directories and files instead of the default 0755 / 0644.
+Use --read-only for managed installs: directories are written 0555 and files
+0444, so installed skills aren't edited by accident and only change by syncing
+again. Later syncs and 'remove' still replace or delete them. Unchanged skills
+keep their permissions, so sync with --dir-mode 0755 --file-mode 0644 to make
+them writable again.
+Use --hardlink to hardlink installed files to their source instead of copying
+them, saving disk space when many projects sync the same large skills. Files
+are copied when linking isn't possible (different filesystem, builtin skills,
+or --strip-comments). Linked files share the source's permissions, so
+--hardlink cannot be combined with --file-mode.
+Use --link-source to install each skill as a symlink to its source directory
+instead, so editing a notebook skill updates every project at once (handy with
+--ecosystem during skill development). Builtin skills are still copied. Linked
+installs break if the source moves, and cannot be combined with --hardlink,
+--render, --strip-comments, --canonicalize, --dir-mode or --file-mode, which
+would otherwise modify the source through the link. Re-run sync without it to
+get copies back.
+Installed files that differ from their source only in line endings or trailing
+whitespace count as unchanged: they are not rewritten and don't show up in
+--diff or --report-drift. Use --exact to compare byte for byte instead.
+Use --self-check to re-read every installed SKILL.md after syncing and validate
+it, catching skills that landed truncated or malformed. Failures are reported
+like other sync errors and make the command exit non-zero.
+Use --detect-renames to catch renamed skill sources: an installed skill that is
+no longer configured is matched to a configured, not yet installed skill with
+the same SKILL.md description and body, and moved to the new name before
+syncing rather than left behind (or pruned and reinstalled). Ambiguous matches
+are left alone. With --report-drift, matches are reported as renamed instead
+of missing and extra.
+Use --report <file> to write a JSON summary of every action taken (synced,
+pruned and skipped skills, errors and durations per workspace).
+Use --json to print the same report as a single JSON object on stdout instead
+of the pretty output: the mode, every destination written, and per workspace
+the synced, skipped and failed skills (with errors), pruned paths and counts.
+Ecosystem and all-workspaces syncs nest one entry per project. The exit code is
+unchanged.
+Use --log-format json to replace the pretty output with JSON lines on stdout:
+one {"event":"error"} object per failure (workspace, skill, phase, message)
+followed by a final {"event":"summary"} object. The command exits non-zero
+if any error event was emitted.
+Use --summary-only for CI: all intermediate output, warnings included, is
+suppressed and a single line is printed at the end:
+
+  synced=3 pruned=1 failed=0 workspaces=1
+
+The exit code is the same as without it, so a failed sync still fails the step.
+Use --prune-scope <scope> or --prune-path <dir> (repeatable) to also remove
+skills that are not configured for this workspace from another location, e.g.
+an old user-scope install when migrating to project scope:
+
+  grove-skills sync --prune-scope user --dry-run
+
+--prune-scope resolves to each configured provider's skills directory in that
+scope. These prunes run independently of --prune and honor --dry-run.
+Use --report-drift to check, without changing anything, how far the installed
+skills have drifted from their sources: counts of missing, modified and extra
+(undeclared) skills in the workspace and its worktrees. Add --json for a
+machine-readable report. The exit code encodes severity: 0 in sync, 2 modified
+or extra skills, 4 missing skills (1 is reserved for command errors).
+A sync that fails for some skills or workspaces but not all exits with 3.
 Use --ecosystem to sync skills for all workspaces in the current ecosystem.
-Use --all-workspaces to sync skills for all registered workspaces.`,
+Use --all-workspaces to sync skills for all registered workspaces.
+In ecosystems where projects use different agents, set providers in each
+project's grove.toml, or pass --provider-map to choose per run:
+
+  grove-skills sync --ecosystem --provider-map api=claude,web=codex
+
+Each listed project syncs all of its skills to that provider only; other
+projects keep their configured providers.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if logFormat != "text" && logFormat != "json" {
+				return fmt.Errorf("invalid --log-format %q (valid: text, json)", logFormat)
+			}
+			reportPath = resolvePath(reportPath)
+			for i, p := range prunePaths {
+				prunePaths[i] = resolvePath(p)
+			}
+			jsonEvents := logFormat == "json"
+			if summaryOnly && (jsonEvents || diff || reportDrift) {
+				return fmt.Errorf("--summary-only cannot be combined with --log-format json, --diff or --report-drift")
+			}
+			if diff && !dryRun {
+				return fmt.Errorf("--diff requires --dry-run")
+			}
+			if diff && jsonEvents {
+				return fmt.Errorf("--diff cannot be combined with --log-format json")
+			}
+			syncJSON := jsonOutput && !reportDrift
+			if syncJSON && (jsonEvents || summaryOnly || diff) {
+				return fmt.Errorf("--json cannot be combined with --log-format json, --summary-only or --diff")
+			}
+			if (pruneScope != "" || len(prunePaths) > 0) && (allWorkspaces || ecosystem) {
+				return fmt.Errorf("--prune-scope and --prune-path cannot be combined with --ecosystem or --all-workspaces")
+			}
+			for project, provider := range providerMap {
+				if !slices.Contains(validProviders, skills.NormalizeProvider(provider)) {
+					return fmt.Errorf("invalid provider %q for %s in --provider-map: %w", provider, project, unknownValueError("provider", provider, validProviders))
+				}
+			}
+			if len(providerMap) > 0 && reportDrift {
+				return fmt.Errorf("--provider-map cannot be combined with --report-drift")
+			}
+			if reportDrift && (allWorkspaces || ecosystem) {
+				return fmt.Errorf("--report-drift checks a single workspace and cannot be combined with --ecosystem or --all-workspaces")
+			}
+
 			logger := logging.NewPrettyLogger()
+			if jsonEvents || summaryOnly || syncJSON {
+				// The pretty logger writes through the global output
+				// regardless of WithWriter, so silence that too to keep
+				// stdout machine-readable.
+				logger = logger.WithWriter(io.Discard)
+				logging.SetGlobalOutput(io.Discard)
+				defer logging.SetGlobalOutput(logOutput)
+			}
 			svc := GetService()
 
-			cwd, err := os.Getwd()
+			cwd, err := workingDir()
 			if err != nil {
 				return fmt.Errorf("could not get current directory: %w", err)
 			}
 
-			node, err := workspace.GetProjectByPath(cwd)
+			node, err := svc.ResolveNode(cwd)
 			if err != nil && !allWorkspaces {
 				return fmt.Errorf("sync requires a workspace context: %w", err)
 			}
@@ -353,52 +1076,430 @@ Use --all-workspaces to sync skills for all registered workspaces.`,
 				}
 			}
 
+			excludeSources, err = skills.ExpandSourcePatterns(excludeSources)
+			if err != nil {
+				return fmt.Errorf("invalid --exclude-source: %w", err)
+			}
+			if profile != "" {
+				profileExcludes, err := resolveProfileExcludes(svc, node, profile)
+				if err != nil {
+					return err
+				}
+				excludeSources = unionTiers(excludeSources, profileExcludes)
+			}
+
+			dirPerm, err := parseFileMode("--dir-mode", dirMode)
+			if err != nil {
+				return err
+			}
+			filePerm, err := parseFileMode("--file-mode", fileMode)
+			if err != nil {
+				return err
+			}
+			if readOnly {
+				if dirPerm != 0 || filePerm != 0 {
+					return fmt.Errorf("--read-only cannot be combined with --dir-mode or --file-mode")
+				}
+				if hardlink || linkSource {
+					return fmt.Errorf("--read-only cannot be combined with --hardlink or --link-source, which share files with the source")
+				}
+				dirPerm, filePerm = skills.ReadOnlyDirMode, skills.ReadOnlyFileMode
+			}
+			if hardlink && filePerm != 0 {
+				return fmt.Errorf("--hardlink cannot be combined with --file-mode")
+			}
+			if linkSource {
+				for _, f := range []struct {
+					name string
+					set  bool
+				}{{"--hardlink", hardlink}, {"--render", render}, {"--strip-comments", stripComments}, {"--canonicalize", canonicalize}, {"--dir-mode", dirPerm != 0}, {"--file-mode", filePerm != 0}} {
+					if f.set {
+						return fmt.Errorf("--link-source cannot be combined with %s", f.name)
+					}
+				}
+				logger.WarnPretty("--link-source: installed skills are symlinks to their sources and will break if those directories move")
+			}
+
+			if pruneScope != "" {
+				scopePaths, err := resolvePruneScopePaths(svc, node, pruneScope)
+				if err != nil {
+					return err
+				}
+				prunePaths = append(prunePaths, scopePaths...)
+			}
+
+			opts := skills.SyncOptions{
+				Prune:           prune,
+				DryRun:          dryRun,
+				Diff:            diff,
+				Merge:           merge,
+				IncludeDisabled: includeDisabled,
+				Since:           since,
+				StripComments:   stripComments,
+				Canonicalize:    canonicalize,
+				Render:          render,
+				ExcludeSources:  excludeSources,
+				WarnShadowed:    warnShadowed,
+				DirMode:         dirPerm,
+				FileMode:        filePerm,
+				Hardlink:        hardlink,
+				LinkSource:      linkSource,
+				Exact:           exact,
+				SelfCheck:       selfCheck,
+				PrunePaths:      prunePaths,
+				AllowEmptyPrune: allowEmptyPrune,
+				NoCreateBase:    noCreateBase,
+				Atomic:          atomic,
+				ProviderMap:     providerMap,
+				DetectRenames:   detectRenames,
+			}
+
+			if reportDrift {
+				return reportSyncDrift(svc, node, opts, jsonOutput, logger)
+			}
+
+			var rep *syncReport
+			if reportPath != "" || jsonEvents || summaryOnly || syncJSON {
+				mode := "workspace"
+				if allWorkspaces {
+					mode = "all-workspaces"
+				} else if ecosystem {
+					mode = "ecosystem"
+				}
+				rep = newSyncReport(mode, dryRun)
+			}
+
 			// Handle multi-workspace sync modes
 			if allWorkspaces || ecosystem {
-				return syncMultipleWorkspaces(svc, node, allWorkspaces, ecosystem, prune, dryRun, logger)
+				err = syncMultipleWorkspaces(svc, node, allWorkspaces, ecosystem, opts, rep, logger)
+			} else {
+				// Single workspace sync
+				err = syncSingleWorkspace(svc, node, opts, rep, logger)
 			}
 
-			// Single workspace sync
-			return syncSingleWorkspace(svc, node, prune, dryRun, logger)
+			if jsonEvents || summaryOnly || syncJSON {
+				write := rep.writeEvents
+				if summaryOnly {
+					write = rep.writeSummaryLine
+				} else if syncJSON {
+					write = rep.writeJSON
+				}
+				if werr := write(os.Stdout); werr != nil && err == nil {
+					err = werr
+				}
+				if err == nil && rep.Totals.Errors > 0 {
+					err = fmt.Errorf("sync finished with %d error(s)", rep.Totals.Errors)
+				}
+			}
+
+			if reportPath != "" {
+				if werr := rep.write(reportPath); werr != nil {
+					if err == nil {
+						return werr
+					}
+					logger.WarnPretty(werr.Error())
+				} else {
+					logger.Path("Wrote sync report", reportPath)
+				}
+			}
+			return err
 		},
 	}
 	cmd.Flags().BoolVar(&prune, "prune", false, "Remove skills from destination that are not in config.")
 	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show what would be synced without making changes.")
+	cmd.Flags().BoolVar(&diff, "diff", false, "With --dry-run, print the content changes each skill would receive.")
 	cmd.Flags().BoolVar(&ecosystem, "ecosystem", false, "Sync skills for all workspaces in the ecosystem.")
 	cmd.Flags().BoolVar(&allWorkspaces, "all-workspaces", false, "Sync skills for all registered workspaces.")
+	cmd.Flags().BoolVar(&atomic, "atomic", false, "Build each provider skills directory aside and swap it into place.")
+	cmd.Flags().BoolVar(&merge, "merge", false, "Overwrite skill files in place without removing extra files in the destination.")
+	cmd.Flags().BoolVar(&includeDisabled, "include-disabled", false, "Sync skills even if they are marked disabled in frontmatter.")
+	cmd.Flags().StringVar(&since, "since", "", "Only sync skills whose source changed since this git ref.")
+	cmd.Flags().BoolVar(&render, "render", false, "Append files listed in each skill's 'includes' frontmatter to the installed SKILL.md.")
+	cmd.Flags().BoolVar(&canonicalize, "canonicalize", false, "Rewrite installed SKILL.md frontmatter in canonical form.")
+	cmd.Flags().BoolVar(&stripComments, "strip-comments", false, "Strip HTML comments and extra blank lines from installed SKILL.md bodies.")
+	cmd.Flags().StringSliceVar(&excludeSources, "exclude-source", nil, "Skip a discovery tier (builtin, user, notebook, ecosystem, project, playbook) or glob; repeatable.")
+	cmd.Flags().StringVar(&profile, "profile", "", "Apply a source selection profile from [skills.profiles].")
+	cmd.Flags().BoolVar(&warnShadowed, "warn-shadowed", false, "Warn when a skill overrides a different lower-precedence copy of the same name.")
+	cmd.Flags().StringVar(&dirMode, "dir-mode", "", "Octal permissions for installed skill directories (e.g. 0750).")
+	cmd.Flags().StringVar(&fileMode, "file-mode", "", "Octal permissions for installed skill files (e.g. 0640).")
+	cmd.Flags().BoolVar(&readOnly, "read-only", false, "Install skills without write permission (0555 directories, 0444 files).")
+	cmd.Flags().BoolVar(&hardlink, "hardlink", false, "Hardlink installed files to their source instead of copying when possible.")
+	cmd.Flags().BoolVar(&allowEmptyPrune, "allow-empty-prune", false, "Let --prune/--prune-path remove every installed skill when none are configured.")
+	cmd.Flags().BoolVar(&noCreateBase, "no-create-base", false, "Fail instead of creating a provider skills directory (e.g. .claude/skills) that doesn't exist.")
+	cmd.Flags().BoolVar(&detectRenames, "detect-renames", false, "Move installed skills whose source was renamed to the new name instead of leaving them orphaned.")
+	cmd.Flags().BoolVar(&exact, "exact", false, "Treat line-ending and trailing-whitespace differences as changes.")
+	cmd.Flags().BoolVar(&linkSource, "link-source", false, "Symlink each installed skill to its source directory (builtin skills are copied).")
+	cmd.Flags().BoolVar(&selfCheck, "self-check", false, "Validate every installed SKILL.md after syncing.")
+	cmd.Flags().StringVar(&logFormat, "log-format", "text", "Output format: 'text' or 'json' (JSON lines of error and summary events).")
+	cmd.Flags().BoolVar(&summaryOnly, "summary-only", false, "Suppress all output and print one summary line of synced/pruned/failed counts at the end.")
+	cmd.Flags().StringVar(&reportPath, "report", "", "Write a JSON report of sync actions to this file.")
+	cmd.Flags().StringVar(&pruneScope, "prune-scope", "", "Also prune unconfigured skills from this scope ('user', 'project', 'ecosystem', 'repo-root').")
+	cmd.Flags().StringSliceVar(&prunePaths, "prune-path", nil, "Also prune unconfigured skills from this skills directory; repeatable.")
+	cmd.Flags().StringToStringVar(&providerMap, "provider-map", nil, "Sync listed projects to one provider each, e.g. api=claude,web=codex.")
+	cmd.Flags().BoolVar(&reportDrift, "report-drift", false, "Report drift from sources without modifying anything; exit code encodes severity.")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Print a JSON summary of the sync (or, with --report-drift, the drift report).")
 	return cmd
 }
 
-// syncSingleWorkspace syncs skills for a single workspace.
-func syncSingleWorkspace(svc *service.Service, node *workspace.WorkspaceNode, prune, dryRun bool, logger *logging.PrettyLogger) error {
-	opts := skills.SyncOptions{Prune: prune, DryRun: dryRun}
-	result, err := skills.SyncWorkspace(svc, node, opts, logger)
+// reportSyncDrift prints the workspace's drift report and returns an error
+// whose exit code encodes its severity: 0 in sync, 2 modified or extra, 4
+// missing.
+func reportSyncDrift(svc *service.Service, node *workspace.WorkspaceNode, opts skills.SyncOptions, jsonOutput bool, logger *logging.PrettyLogger) error {
+	report, err := skills.ReportDrift(svc, node, opts)
 	if err != nil {
-		return fmt.Errorf("sync failed: %w", err)
+		return fmt.Errorf("drift report failed: %w", err)
 	}
 
-	if dryRun {
-		if len(result.SyncedSkills) > 0 {
-			logger.InfoPretty(fmt.Sprintf("DRY RUN: Would sync %d skills to %s", len(result.SyncedSkills), node.Name))
-			for _, name := range result.SyncedSkills {
-				logger.InfoPretty(fmt.Sprintf("  - %s", name))
+	if jsonOutput {
+		out, err := marshalEnvelope("drift", report)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+	} else {
+		logger.InfoPretty(fmt.Sprintf("Drift for %s: %d missing, %d modified, %d extra",
+			report.Workspace, len(report.Missing), len(report.Modified), len(report.Extra)))
+		for _, r := range report.Renamed {
+			logger.InfoPretty(fmt.Sprintf("  %-8s %s -> %s", "renamed", r.Path, r.To))
+		}
+		for _, group := range []struct {
+			label string
+			paths []string
+		}{{"missing", report.Missing}, {"modified", report.Modified}, {"extra", report.Extra}} {
+			for _, path := range group.paths {
+				logger.InfoPretty(fmt.Sprintf("  %-8s %s", group.label, path))
 			}
-		} else {
-			logger.InfoPretty(fmt.Sprintf("DRY RUN: No skills to sync for %s", node.Name))
 		}
+	}
+
+	switch report.Severity() {
+	case skills.DriftMissing:
+		return withExitCode(ExitNotFound, fmt.Errorf("%d configured skill(s) not installed", len(report.Missing)))
+	case skills.DriftChanged:
+		return withExitCode(ExitValidation, fmt.Errorf("installed skills drifted: %d modified, %d extra", len(report.Modified), len(report.Extra)))
+	}
+	return nil
+}
+
+// syncSingleWorkspace syncs skills for a single workspace.
+func syncSingleWorkspace(svc *service.Service, node *workspace.WorkspaceNode, opts skills.SyncOptions, rep *syncReport, logger *logging.PrettyLogger) error {
+	start := time.Now()
+	result, err := skills.SyncWorkspace(svc, node, opts, logger)
+	rep.add(node.Name, result, err, time.Since(start))
+	if err != nil {
+		err = fmt.Errorf("sync failed: %w", err)
+		if isPartialSync(result) {
+			return withExitCode(ExitPartial, err)
+		}
+		return err
+	}
+
+	warnSyncNotices(result, logger)
+
+	if opts.DryRun {
+		printDryRunPlan(result, logger)
+		logger.Success(fmt.Sprintf("DRY RUN: %d installs and %d prunes planned for %s (%d skills up to date); nothing was written",
+			len(result.PlannedInstalls), len(result.PrunedPaths), node.Name, len(result.UpToDate)))
 		return nil
 	}
 
-	if len(result.SyncedSkills) > 0 {
-		logger.Success(fmt.Sprintf("Synced %d skills for %s", len(result.SyncedSkills), node.Name))
+	if len(result.SyncedSkills) > 0 || len(result.UpToDate) > 0 {
+		logger.Success(fmt.Sprintf("%s: %d synced, %d up to date", node.Name, len(result.SyncedSkills), len(result.UpToDate)))
 	} else {
 		logger.InfoPretty(fmt.Sprintf("No skills to sync for %s", node.Name))
 	}
 	return nil
 }
 
+// isPartialSync reports whether a failed sync still left some skills
+// installed, i.e. fewer skills failed than were synced or already up to date.
+func isPartialSync(result *skills.SyncResult) bool {
+	if result == nil || len(result.Errors) == 0 {
+		return false
+	}
+	failed := make(map[string]bool, len(result.Errors))
+	for _, e := range result.Errors {
+		failed[e.Skill] = true
+	}
+	return len(failed) < len(result.SyncedSkills)+len(result.UpToDate)
+}
+
+// resolvePruneScopePaths returns the skills directory of every provider
+// configured for node in the given install scope.
+func resolvePruneScopePaths(svc *service.Service, node *workspace.WorkspaceNode, scope string) ([]string, error) {
+	if !slices.Contains(validScopes, scope) {
+		return nil, unknownValueError("scope", scope, validScopes)
+	}
+	var cfg *coreconfig.Config
+	if svc != nil {
+		cfg = svc.Config
+	}
+	skillsCfg, err := skills.LoadSkillsConfig(cfg, node)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load skills config: %w", err)
+	}
+	providers := []string{"claude"}
+	if skillsCfg != nil && len(skillsCfg.Providers) > 0 {
+		providers = skillsCfg.Providers
+	}
+
+	var paths []string
+	for _, provider := range providers {
+		path, err := getInstallPath(provider, scope)
+		if err != nil {
+			return nil, fmt.Errorf("could not resolve --prune-scope %s for %s: %w", scope, provider, err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// resolveProfileExcludes returns the tiers excluded by the named source profile
+// from the merged [skills] config of node (or the global config if node is nil).
+func resolveProfileExcludes(svc *service.Service, node *workspace.WorkspaceNode, profile string) ([]string, error) {
+	var cfg *coreconfig.Config
+	if svc != nil {
+		cfg = svc.Config
+	}
+	skillsCfg, err := skills.LoadSkillsConfig(cfg, node)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load skills config: %w", err)
+	}
+	return skillsCfg.ProfileExcludes(profile)
+}
+
+// unionTiers merges two tier lists, keeping SourceTiers order.
+func unionTiers(a, b []string) []string {
+	var tiers []string
+	for _, tier := range skills.SourceTiers {
+		if slices.Contains(a, tier) || slices.Contains(b, tier) {
+			tiers = append(tiers, tier)
+		}
+	}
+	return tiers
+}
+
+// parseFileMode parses an octal permission string such as "0750". An empty
+// string yields 0, meaning "keep the default".
+func parseFileMode(flag, value string) (os.FileMode, error) {
+	if value == "" {
+		return 0, nil
+	}
+	mode, err := strconv.ParseUint(value, 8, 32)
+	if err != nil || mode > 0o777 {
+		return 0, fmt.Errorf("invalid %s %q: expected octal permissions between 0000 and 0777", flag, value)
+	}
+	return os.FileMode(mode), nil
+}
+
+// printDryRunPlan lists the renamed skills a dry run would move, the skills it
+// would install and where, and those it would prune, and prints any collected
+// content diffs to stdout.
+func printDryRunPlan(result *skills.SyncResult, logger *logging.PrettyLogger) {
+	for _, r := range result.Renamed {
+		logger.InfoPretty(fmt.Sprintf("DRY RUN: Would move renamed skill '%s' to: %s", r.From, r.NewPath()))
+	}
+	for _, path := range result.PlannedInstalls {
+		logger.InfoPretty(fmt.Sprintf("DRY RUN: Would install %s to %s", filepath.Base(path), path))
+	}
+	if len(result.PrunedPaths) > 0 {
+		logger.InfoPretty(fmt.Sprintf("DRY RUN: Would prune %d skills from %s", len(result.PrunedPaths), result.Workspace))
+		for _, path := range result.PrunedPaths {
+			logger.InfoPretty(fmt.Sprintf("  - %s", path))
+		}
+	}
+	for _, d := range result.Diffs {
+		fmt.Print(d.Diff)
+	}
+}
+
+// warnSyncNotices prints a warning for a refused prune-to-empty, for each
+// synced skill marked deprecated or defined twice in one tier, and for each
+// skill shadowing a different lower-precedence copy.
+func warnSyncNotices(result *skills.SyncResult, logger *logging.PrettyLogger) {
+	if result.PruneSkipped != "" {
+		logger.WarnPretty(fmt.Sprintf("Not pruning %s: %s, so every installed skill would be removed. Check grove.toml and re-run with --allow-empty-prune if this is intended.",
+			result.Workspace, result.PruneSkipped))
+	}
+
+	names := make([]string, 0, len(result.Deprecated))
+	for name := range result.Deprecated {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		logger.WarnPretty(fmt.Sprintf("Skill '%s' is deprecated: %s", name, result.Deprecated[name]))
+	}
+
+	warnDuplicateSkills(result.Duplicates, logger)
+
+	shadowed := append([]skills.ShadowedSkill(nil), result.Shadowed...)
+	sort.Slice(shadowed, func(i, j int) bool { return shadowed[i].Name < shadowed[j].Name })
+	for _, sh := range shadowed {
+		logger.WarnPretty(fmt.Sprintf("Skill '%s' from %s (%s) shadows a different copy in %s (%s)",
+			sh.Name, sh.Winner.Type, sh.Winner.Path, sh.Tier, sh.Shadowed.Path))
+	}
+}
+
+// warnDuplicateSkills prints a warning for each skill defined more than once
+// within one discovery tier, naming the copy that is used and those ignored.
+func warnDuplicateSkills(dups []skills.DuplicateSkill, logger *logging.PrettyLogger) {
+	for _, dup := range dups {
+		logger.WarnPretty(fmt.Sprintf("Skill '%s' is defined more than once in the %s tier; using %s, ignoring %s",
+			dup.Name, dup.Tier, dup.Winner, strings.Join(dup.Others, ", ")))
+	}
+}
+
+// ecosystemNodes returns the registered workspaces belonging to the ecosystem
+// that contains currentNode, including the ecosystem root itself.
+func ecosystemNodes(currentNode *workspace.WorkspaceNode) ([]*workspace.WorkspaceNode, error) {
+	if currentNode == nil {
+		return nil, fmt.Errorf("--ecosystem requires being in a workspace")
+	}
+	nodes, err := workspace.GetProjects(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspaces: %w", err)
+	}
+	// Filter to ecosystem workspaces
+	ecoPath := currentNode.RootEcosystemPath
+	if ecoPath == "" {
+		// If current node is the ecosystem root, use its path
+		if currentNode.Kind == workspace.KindEcosystemRoot || currentNode.Kind == workspace.KindEcosystemWorktree {
+			ecoPath = currentNode.Path
+		} else {
+			return nil, fmt.Errorf("current directory is not part of an ecosystem")
+		}
+	}
+	var filtered []*workspace.WorkspaceNode
+	for _, n := range nodes {
+		if n.RootEcosystemPath == ecoPath || n.Path == ecoPath {
+			filtered = append(filtered, n)
+		}
+	}
+	return filtered, nil
+}
+
+// warnUnmatchedProviderMap warns about --provider-map entries that name none
+// of the workspaces being synced, which are usually typos.
+func warnUnmatchedProviderMap(providerMap map[string]string, nodes []*workspace.WorkspaceNode, logger *logging.PrettyLogger) {
+	names := make(map[string]bool, len(nodes))
+	for _, n := range nodes {
+		names[n.Name] = true
+	}
+	unmatched := make([]string, 0, len(providerMap))
+	for project := range providerMap {
+		if !names[project] {
+			unmatched = append(unmatched, project)
+		}
+	}
+	if len(unmatched) > 0 {
+		sort.Strings(unmatched)
+		logger.WarnPretty(fmt.Sprintf("--provider-map: no workspace named %s", strings.Join(unmatched, ", ")))
+	}
+}
+
 // syncMultipleWorkspaces syncs skills for all workspaces or ecosystem workspaces.
-func syncMultipleWorkspaces(svc *service.Service, currentNode *workspace.WorkspaceNode, allWorkspaces, ecosystem, prune, dryRun bool, logger *logging.PrettyLogger) error {
+func syncMultipleWorkspaces(svc *service.Service, currentNode *workspace.WorkspaceNode, allWorkspaces, ecosystem bool, opts skills.SyncOptions, rep *syncReport, logger *logging.PrettyLogger) error {
 	var nodes []*workspace.WorkspaceNode
 	var err error
 
@@ -409,31 +1510,10 @@ func syncMultipleWorkspaces(svc *service.Service, currentNode *workspace.Workspa
 			return fmt.Errorf("failed to get workspaces: %w", err)
 		}
 	} else if ecosystem {
-		// Get workspaces in the current ecosystem
-		if currentNode == nil {
-			return fmt.Errorf("--ecosystem requires being in a workspace")
-		}
-		nodes, err = workspace.GetProjects(nil)
+		nodes, err = ecosystemNodes(currentNode)
 		if err != nil {
-			return fmt.Errorf("failed to get workspaces: %w", err)
+			return err
 		}
-		// Filter to ecosystem workspaces
-		ecoPath := currentNode.RootEcosystemPath
-		if ecoPath == "" {
-			// If current node is the ecosystem root, use its path
-			if currentNode.Kind == workspace.KindEcosystemRoot || currentNode.Kind == workspace.KindEcosystemWorktree {
-				ecoPath = currentNode.Path
-			} else {
-				return fmt.Errorf("current directory is not part of an ecosystem")
-			}
-		}
-		var filtered []*workspace.WorkspaceNode
-		for _, n := range nodes {
-			if n.RootEcosystemPath == ecoPath || n.Path == ecoPath {
-				filtered = append(filtered, n)
-			}
-		}
-		nodes = filtered
 	}
 
 	if len(nodes) == 0 {
@@ -442,50 +1522,69 @@ func syncMultipleWorkspaces(svc *service.Service, currentNode *workspace.Workspa
 	}
 
 	logger.InfoPretty(fmt.Sprintf("Syncing skills for %d workspaces...", len(nodes)))
+	warnUnmatchedProviderMap(opts.ProviderMap, nodes, logger)
 
-	var totalSynced, successCount int
+	var totalSynced, totalUpToDate, totalInstalls, totalPrunes, successCount, failCount int
 	for _, node := range nodes {
+		if err := svc.Context().Err(); err != nil {
+			return fmt.Errorf("sync aborted after %d of %d workspaces: %w", successCount+failCount, len(nodes), err)
+		}
 		// Create service for each node if needed
 		nodeSvc := svc
 		if nodeSvc == nil {
 			nodeSvc, err = skills.NewServiceForNode(node)
 			if err != nil {
 				logger.WarnPretty(fmt.Sprintf("Skipping %s: %v", node.Name, err))
+				rep.add(node.Name, nil, err, 0)
+				failCount++
 				continue
 			}
 		}
 
-		opts := skills.SyncOptions{Prune: prune, DryRun: dryRun}
+		start := time.Now()
 		result, err := skills.SyncWorkspace(nodeSvc, node, opts, nil)
+		rep.add(node.Name, result, err, time.Since(start))
 		if err != nil {
 			logger.WarnPretty(fmt.Sprintf("Failed to sync %s: %v", node.Name, err))
+			failCount++
 			continue
 		}
-
-		if len(result.SyncedSkills) > 0 {
-			if dryRun {
-				logger.InfoPretty(fmt.Sprintf("  %s: would sync %d skills", node.Name, len(result.SyncedSkills)))
-			} else {
-				logger.InfoPretty(fmt.Sprintf("  %s: synced %d skills", node.Name, len(result.SyncedSkills)))
-			}
-			totalSynced += len(result.SyncedSkills)
+		warnSyncNotices(result, logger)
+		if opts.DryRun {
+			printDryRunPlan(result, logger)
+			logger.InfoPretty(fmt.Sprintf("  %s: %d installs and %d prunes planned", node.Name, len(result.PlannedInstalls), len(result.PrunedPaths)))
+			totalInstalls += len(result.PlannedInstalls)
+			totalPrunes += len(result.PrunedPaths)
+		} else if len(result.SyncedSkills) > 0 || len(result.UpToDate) > 0 {
+			logger.InfoPretty(fmt.Sprintf("  %s: %d synced, %d up to date", node.Name, len(result.SyncedSkills), len(result.UpToDate)))
 		}
+		totalSynced += len(result.SyncedSkills)
+		totalUpToDate += len(result.UpToDate)
 		successCount++
 	}
 
-	if dryRun {
-		logger.Success(fmt.Sprintf("DRY RUN: Would sync %d total skills across %d workspaces", totalSynced, successCount))
+	if opts.DryRun {
+		logger.Success(fmt.Sprintf("DRY RUN: %d installs and %d prunes planned across %d workspaces (%d skills up to date); nothing was written",
+			totalInstalls, totalPrunes, successCount, totalUpToDate))
 	} else {
-		logger.Success(fmt.Sprintf("Synced %d total skills across %d workspaces", totalSynced, successCount))
+		logger.Success(fmt.Sprintf("%d synced, %d up to date across %d workspaces", totalSynced, totalUpToDate, successCount))
+	}
+	if failCount > 0 {
+		err := fmt.Errorf("%d of %d workspaces failed to sync", failCount, len(nodes))
+		if successCount > 0 {
+			return withExitCode(ExitPartial, err)
+		}
+		return err
 	}
 	return nil
 }
 
 func newSkillsTreeCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "tree <name>",
-		Short: "Visualize the dependency tree of a skill",
-		Args:  cobra.ExactArgs(1),
+		Use:               "tree <name>",
+		Short:             "Visualize the dependency tree of a skill",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeSkillNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			name := args[0]
 			svc := GetService()
@@ -501,41 +1600,92 @@ func newSkillsTreeCmd() *cobra.Command {
 	return cmd
 }
 
+// removeResult is the --json output of the remove command.
+type removeResult struct {
+	Removed string `json:"removed,omitempty"`
+	Skill   string `json:"skill,omitempty"`
+	Path    string `json:"path,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
 func newSkillsRemoveCmd() *cobra.Command {
 	var scope, provider string
+	var jsonOutput bool
 	cmd := &cobra.Command{
-		Use:   "remove <name>",
-		Short: "Remove an installed skill",
-		Args:  cobra.ExactArgs(1),
+		Use:               "remove <name>",
+		Short:             "Remove an installed skill",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeSkillNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			name := args[0]
-			basePath, err := getInstallPath(provider, scope)
-			if err != nil {
+			skillPath, err := removeInstalledSkill(name, scope, provider)
+			if jsonOutput {
+				result := removeResult{Removed: name, Path: skillPath}
+				if err != nil {
+					result = removeResult{Skill: name, Path: skillPath, Error: err.Error()}
+				}
+				out, merr := marshalEnvelope("result", result)
+				if merr != nil {
+					return fmt.Errorf("failed to marshal JSON: %w", merr)
+				}
+				fmt.Println(string(out))
 				return err
 			}
-			logger := logging.NewPrettyLogger()
-
-			skillPath := filepath.Join(basePath, name)
-			if _, err := os.Stat(skillPath); os.IsNotExist(err) {
-				return fmt.Errorf("skill '%s' not found at %s", name, skillPath)
-			}
-
-			if err := os.RemoveAll(skillPath); err != nil {
-				return fmt.Errorf("failed to remove skill '%s': %w", name, err)
+			if err != nil {
+				return err
 			}
 
+			logger := logging.NewPrettyLogger()
 			logger.Success(fmt.Sprintf("Skill '%s' removed.", name))
 			logger.Path("  Removed from", skillPath)
 			return nil
 		},
 	}
 	cmd.Flags().StringVar(&scope, "scope", "user", "Scope to remove from ('project', 'user', 'ecosystem', 'repo-root', or 'admin' for codex).")
-	cmd.Flags().StringVar(&provider, "provider", "claude", "Agent provider ('claude', 'codex', 'opencode').")
+	cmd.Flags().StringVar(&provider, "provider", "claude", "Agent provider ('claude', 'codex', 'opencode'; aliases 'cc', 'cx', 'oc').")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output the removal result in JSON format")
 	return cmd
 }
 
+// removeInstalledSkill deletes an installed skill and records it in the
+// history log. It returns the skill path, which is empty if the install
+// location could not be determined.
+func removeInstalledSkill(name, scope, provider string) (string, error) {
+	if err := validateScopeAndProvider(scope, provider); err != nil {
+		return "", err
+	}
+	basePath, err := getInstallPath(provider, scope)
+	if err != nil {
+		return "", err
+	}
+
+	skillPath := filepath.Join(basePath, name)
+	if _, err := os.Stat(skillPath); os.IsNotExist(err) {
+		return skillPath, withExitCode(ExitNotFound, fmt.Errorf("skill '%s' not found at %s", name, skillPath))
+	}
+
+	if err := skills.RemoveSkillDir(skillPath); err != nil {
+		return skillPath, fmt.Errorf("failed to remove skill '%s': %w", name, err)
+	}
+	_ = skills.AppendHistory(skills.HistoryEntry{
+		Action:   skills.HistoryActionRemove,
+		Skill:    name,
+		Scope:    scope,
+		Provider: skills.NormalizeProvider(provider),
+		Path:     skillPath,
+	})
+	return skillPath, nil
+}
+
+// validScopes and validProviders are the values accepted by --scope and --provider.
+var (
+	validScopes    = []string{"user", "project", "ecosystem", "repo-root", "admin"}
+	validProviders = skills.DestinationProviders()
+)
+
 func getInstallPath(provider, scope string) (string, error) {
 	var pathParts []string
+	provider = skills.NormalizeProvider(provider)
 
 	switch scope {
 	case "user":
@@ -545,14 +1695,17 @@ func getInstallPath(provider, scope string) (string, error) {
 		}
 		pathParts = append(pathParts, home)
 	case "project":
-		// Uses current working directory, so pathParts remains empty initially
+		// Relative to the working directory, or rooted at --context
 		pathParts = []string{}
+		if contextDir != "" {
+			pathParts = append(pathParts, contextDir)
+		}
 	case "ecosystem":
-		cwd, err := os.Getwd()
+		cwd, err := workingDir()
 		if err != nil {
 			return "", err
 		}
-		node, err := workspace.GetProjectByPath(cwd)
+		node, err := GetService().ResolveNode(cwd)
 		if err != nil {
 			return "", fmt.Errorf("could not determine workspace context for ecosystem scope: %w", err)
 		}
@@ -566,7 +1719,7 @@ func getInstallPath(provider, scope string) (string, error) {
 			return "", fmt.Errorf("current directory is not part of an ecosystem (kind=%s)", node.Kind)
 		}
 	case "repo-root":
-		cwd, err := os.Getwd()
+		cwd, err := workingDir()
 		if err != nil {
 			return "", err
 		}
@@ -575,31 +1728,12 @@ func getInstallPath(provider, scope string) (string, error) {
 			return "", fmt.Errorf("could not find git repository root for 'repo-root' scope: %w", err)
 		}
 		pathParts = append(pathParts, gitRoot)
-	case "admin":
-		if strings.ToLower(provider) != "codex" {
-			return "", fmt.Errorf("'admin' scope is only supported for the 'codex' provider")
-		}
+	case skills.ScopeAdmin:
 		// For admin scope, the path is absolute under /etc
 		pathParts = append(pathParts, "/etc")
 	default:
 		return "", fmt.Errorf("invalid scope: %s (valid: 'user', 'project', 'ecosystem', 'repo-root', 'admin')", scope)
 	}
 
-	switch strings.ToLower(provider) {
-	case "claude":
-		pathParts = append(pathParts, ".claude", "skills")
-	case "codex":
-		if scope == "admin" {
-			// Admin scope uses /etc/codex/skills (no leading dot)
-			pathParts = append(pathParts, "codex", "skills")
-		} else {
-			pathParts = append(pathParts, ".codex", "skills")
-		}
-	case "opencode":
-		pathParts = append(pathParts, ".opencode", "skill")
-	default:
-		return "", fmt.Errorf("unsupported provider: %s", provider)
-	}
-
-	return filepath.Join(pathParts...), nil
+	return skills.ProviderSkillsDir(filepath.Join(pathParts...), provider, scope)
 }
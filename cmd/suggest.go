@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/grovetools/skills/pkg/skills"
+)
+
+// validateScopeAndProvider checks --scope and --provider against the known
+// values before any work is done, suggesting the closest match on a typo.
+func validateScopeAndProvider(scope, provider string) error {
+	if !slices.Contains(validScopes, scope) {
+		return unknownValueError("scope", scope, validScopes)
+	}
+	if !slices.Contains(validProviders, skills.NormalizeProvider(provider)) {
+		return unknownValueError("provider", provider, validProviders)
+	}
+	return nil
+}
+
+// unknownValueError reports an invalid flag value, with a "did you mean"
+// hint when a valid value is within a couple of edits.
+func unknownValueError(kind, value string, valid []string) error {
+	if suggestion := closestMatch(strings.ToLower(value), valid); suggestion != "" {
+		return fmt.Errorf("unknown %s '%s', did you mean '%s'? (valid: %s)", kind, value, suggestion, strings.Join(valid, ", "))
+	}
+	return fmt.Errorf("unknown %s '%s' (valid: %s)", kind, value, strings.Join(valid, ", "))
+}
+
+// closestMatch returns the candidate with the smallest edit distance to value
+// (at most two edits), falling back to a candidate that value is a prefix of.
+// It returns "" if nothing is a plausible typo.
+func closestMatch(value string, candidates []string) string {
+	best, bestDist := "", 3
+	for _, c := range candidates {
+		if d := editDistance(value, c); d < bestDist {
+			best, bestDist = c, d
+		}
+	}
+	if best != "" || value == "" {
+		return best
+	}
+	for _, c := range candidates {
+		if strings.HasPrefix(c, value) {
+			return c
+		}
+	}
+	return ""
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,233 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/grovetools/skills/pkg/skills"
+)
+
+// syncReport is the structured summary written by `sync --report` and
+// printed by `sync --json`. Destinations lists every skills directory written
+// to, across all workspaces.
+type syncReport struct {
+	Mode         string            `json:"mode"`
+	DryRun       bool              `json:"dry_run"`
+	StartedAt    time.Time         `json:"started_at"`
+	DurationMS   int64             `json:"duration_ms"`
+	Destinations []string          `json:"destinations"`
+	Workspaces   []syncReportEntry `json:"workspaces"`
+	Totals       syncReportTotals  `json:"totals"`
+}
+
+// syncReportEntry records the actions taken for a single workspace. Skills
+// repeats the synced, up-to-date, skipped and failed skills as one result per
+// skill.
+type syncReportEntry struct {
+	Workspace  string            `json:"workspace"`
+	Synced     []string          `json:"synced"`
+	UpToDate   []string          `json:"up_to_date"`
+	Pruned     []string          `json:"pruned"`
+	Skipped    []string          `json:"skipped"`
+	DestPaths  []string          `json:"dest_paths"`
+	Error      string            `json:"error,omitempty"`
+	Errors     []syncReportError `json:"errors"`
+	Skills     []syncSkillResult `json:"skills"`
+	DurationMS int64             `json:"duration_ms"`
+}
+
+// syncSkillResult is the outcome for one skill within a workspace sync.
+type syncSkillResult struct {
+	Name   string `json:"name"`
+	Status string `json:"status"` // "synced", "up-to-date", "skipped" or "failed"
+	Error  string `json:"error,omitempty"`
+}
+
+// syncReportError is one failure within a workspace sync.
+type syncReportError struct {
+	Skill   string `json:"skill,omitempty"`
+	Phase   string `json:"phase"`
+	Message string `json:"message"`
+}
+
+// syncReportTotals aggregates counts across all workspaces in a report.
+type syncReportTotals struct {
+	Workspaces int `json:"workspaces"`
+	Synced     int `json:"synced"`
+	UpToDate   int `json:"up_to_date"`
+	Pruned     int `json:"pruned"`
+	Skipped    int `json:"skipped"`
+	Errors     int `json:"errors"`
+}
+
+func newSyncReport(mode string, dryRun bool) *syncReport {
+	return &syncReport{
+		Mode:         mode,
+		DryRun:       dryRun,
+		StartedAt:    time.Now(),
+		Destinations: []string{},
+		Workspaces:   []syncReportEntry{},
+	}
+}
+
+// skillResults merges an entry's synced, skipped and failed skills into one
+// result per skill. A skill that failed in any destination is reported as
+// failed with its first error.
+func skillResults(entry syncReportEntry) []syncSkillResult {
+	byName := make(map[string]syncSkillResult)
+	for _, name := range entry.Synced {
+		byName[name] = syncSkillResult{Name: name, Status: "synced"}
+	}
+	for _, name := range entry.UpToDate {
+		byName[name] = syncSkillResult{Name: name, Status: "up-to-date"}
+	}
+	for _, name := range entry.Skipped {
+		byName[name] = syncSkillResult{Name: name, Status: "skipped"}
+	}
+	for _, e := range entry.Errors {
+		if e.Skill == "" {
+			continue
+		}
+		if prev, ok := byName[e.Skill]; ok && prev.Status == "failed" {
+			continue
+		}
+		byName[e.Skill] = syncSkillResult{Name: e.Skill, Status: "failed", Error: e.Message}
+	}
+
+	results := make([]syncSkillResult, 0, len(byName))
+	for _, r := range byName {
+		results = append(results, r)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+	return results
+}
+
+// add records the outcome of syncing one workspace. A nil report is a no-op so
+// callers don't need to check whether --report was requested.
+func (r *syncReport) add(name string, result *skills.SyncResult, err error, elapsed time.Duration) {
+	if r == nil {
+		return
+	}
+
+	entry := syncReportEntry{
+		Workspace:  name,
+		Synced:     []string{},
+		UpToDate:   []string{},
+		Pruned:     []string{},
+		Skipped:    []string{},
+		DestPaths:  []string{},
+		Errors:     []syncReportError{},
+		Skills:     []syncSkillResult{},
+		DurationMS: elapsed.Milliseconds(),
+	}
+	if result != nil {
+		entry.Synced = append(entry.Synced, result.SyncedSkills...)
+		entry.UpToDate = append(entry.UpToDate, result.UpToDate...)
+		entry.Pruned = append(entry.Pruned, result.PrunedPaths...)
+		entry.Skipped = append(entry.Skipped, result.SkippedSkills...)
+		entry.DestPaths = append(entry.DestPaths, result.DestPaths...)
+		sort.Strings(entry.Synced)
+		sort.Strings(entry.UpToDate)
+		sort.Strings(entry.Pruned)
+		sort.Strings(entry.Skipped)
+		sort.Strings(entry.DestPaths)
+		for _, se := range result.Errors {
+			entry.Errors = append(entry.Errors, syncReportError{Skill: se.Skill, Phase: se.Phase, Message: se.Error()})
+		}
+		entry.Skills = skillResults(entry)
+	}
+	if err != nil {
+		entry.Error = err.Error()
+		// Failures before any skill was written (config, resolution) have
+		// no per-skill entry; record them against the workspace.
+		if len(entry.Errors) == 0 {
+			entry.Errors = append(entry.Errors, syncReportError{Phase: skills.SyncPhaseResolve, Message: err.Error()})
+		}
+	}
+	r.Totals.Errors += len(entry.Errors)
+
+	for _, dest := range entry.DestPaths {
+		if !slices.Contains(r.Destinations, dest) {
+			r.Destinations = append(r.Destinations, dest)
+		}
+	}
+	r.Workspaces = append(r.Workspaces, entry)
+	r.Totals.Workspaces++
+	r.Totals.Synced += len(entry.Synced)
+	r.Totals.UpToDate += len(entry.UpToDate)
+	r.Totals.Pruned += len(entry.Pruned)
+	r.Totals.Skipped += len(entry.Skipped)
+}
+
+// write finalizes the report duration and writes it as indented JSON to path.
+func (r *syncReport) write(path string) error {
+	r.DurationMS = time.Since(r.StartedAt).Milliseconds()
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode sync report: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil { //nolint:gosec // G306: report is user-readable output
+		return fmt.Errorf("failed to write sync report: %w", err)
+	}
+	return nil
+}
+
+// writeJSON prints the report to w wrapped in the standard JSON envelope, for
+// sync --json.
+func (r *syncReport) writeJSON(w io.Writer) error {
+	r.DurationMS = time.Since(r.StartedAt).Milliseconds()
+
+	out, err := marshalEnvelope("sync", r)
+	if err != nil {
+		return fmt.Errorf("failed to encode sync report: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
+
+// writeEvents emits the report as JSON lines for --log-format json: one
+// "error" event per failure followed by a single "summary" event.
+func (r *syncReport) writeEvents(w io.Writer) error {
+	r.DurationMS = time.Since(r.StartedAt).Milliseconds()
+
+	enc := json.NewEncoder(w)
+	for _, ws := range r.Workspaces {
+		for _, e := range ws.Errors {
+			event := struct {
+				Event     string `json:"event"`
+				Workspace string `json:"workspace"`
+				syncReportError
+			}{"error", ws.Workspace, e}
+			if err := enc.Encode(event); err != nil {
+				return err
+			}
+		}
+	}
+
+	summary := struct {
+		Event      string `json:"event"`
+		Mode       string `json:"mode"`
+		DryRun     bool   `json:"dry_run"`
+		DurationMS int64  `json:"duration_ms"`
+		syncReportTotals
+	}{"summary", r.Mode, r.DryRun, r.DurationMS, r.Totals}
+	return enc.Encode(summary)
+}
+
+// writeSummaryLine prints the report totals as a single line for
+// --summary-only, e.g. "synced=3 up_to_date=2 pruned=1 failed=0 workspaces=1".
+func (r *syncReport) writeSummaryLine(w io.Writer) error {
+	prefix := ""
+	if r.DryRun {
+		prefix = "dry-run "
+	}
+	_, err := fmt.Fprintf(w, "%ssynced=%d up_to_date=%d pruned=%d failed=%d workspaces=%d\n",
+		prefix, r.Totals.Synced, r.Totals.UpToDate, r.Totals.Pruned, r.Totals.Errors, r.Totals.Workspaces)
+	return err
+}
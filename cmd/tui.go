@@ -40,11 +40,11 @@ Actions:
 			}
 
 			// Try to determine current workspace context
-			cwd, err := os.Getwd()
+			cwd, err := workingDir()
 			if err != nil {
 				return fmt.Errorf("could not get current directory: %w", err)
 			}
-			node, _ := workspace.GetProjectByPath(cwd) // Ignore error, node will be nil if not in workspace
+			node, _ := svc.ResolveNode(cwd) // Ignore error, node will be nil if not in workspace
 
 			model := skillsview.New(svc, svc.Config, node)
 			compModel := compositor.NewModel(model)
@@ -3,35 +3,84 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"sort"
+	"strings"
 
 	"github.com/grovetools/core/pkg/workspace"
+	"github.com/grovetools/skills/pkg/service"
 	"github.com/grovetools/skills/pkg/skills"
 	"github.com/spf13/cobra"
 )
 
 func newSkillsValidateCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:   "validate",
-		Short: "Validate skills declared in grove.toml",
+	var schemaPath string
+	var strict, recursive bool
+	var jobs int
+
+	cmd := &cobra.Command{
+		Use:   "validate [skill-dir...]",
+		Short: "Validate skills declared in grove.toml or local skill directories",
 		Long: `Validate that all skills declared in grove.toml can be resolved.
 
 This command reads the [skills] block from grove.toml and verifies that
 each declared skill exists and can be found in the available sources
-(built-in, user, ecosystem, or project).
+(built-in, user, ecosystem, or project). It also fails if a resolved skill
+declares an alias that names another skill or is claimed by another skill,
+or lists an "includes" file that is not part of the skill.
+
+Use --against-schema to additionally validate each resolved skill's SKILL.md
+frontmatter against a JSON Schema file, for teams that extend frontmatter
+with custom fields.
+
+Use --strict to also decode each resolved skill's frontmatter strictly,
+rejecting fields grove-skills does not know and duplicate keys in JSON
+frontmatter (which are otherwise accepted, last one winning; YAML and TOML
+always reject them). YAML errors include the line.
+Skills with custom fields should use --against-schema instead.
+
+Pass one or more skill directories to lint them instead, e.g. before
+publishing: each SKILL.md is checked with the rules sync applies, expecting the
+skill name to match the directory name, and every problem is listed under its
+skill. No workspace is needed. With --recursive each argument is a skills root
+and every immediate subdirectory is validated. --strict and --against-schema
+apply here too.
+
+Skills are checked in parallel, --jobs at a time (default: the number of
+CPUs); results are always reported in name order.
 
 Exit codes:
   0 - All skills validated successfully
-  1 - One or more skills could not be resolved`,
+  1 - The command itself failed (bad flags, unreadable schema...)
+  2 - One or more skills could not be resolved, have ambiguous aliases or
+      missing includes, or failed strict or schema validation (including
+      skills passed as directories)`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			schemaPath = resolvePath(schemaPath)
 			svc := GetService()
 
-			cwd, err := os.Getwd()
+			var schema *skills.FrontmatterSchema
+			if schemaPath != "" {
+				var err error
+				schema, err = skills.LoadFrontmatterSchema(schemaPath)
+				if err != nil {
+					return err
+				}
+			}
+
+			if recursive && len(args) == 0 {
+				return fmt.Errorf("--recursive requires at least one skills directory")
+			}
+			if len(args) > 0 {
+				return validateSkillDirs(args, recursive, strict, schema, jobs)
+			}
+
+			cwd, err := workingDir()
 			if err != nil {
 				return fmt.Errorf("could not get current directory: %w", err)
 			}
 
-			node, err := workspace.GetProjectByPath(cwd)
+			node, err := GetService().ResolveNode(cwd)
 			if err != nil {
 				return fmt.Errorf("validate requires a workspace context: %w", err)
 			}
@@ -69,8 +118,7 @@ Exit codes:
 			// Try to resolve all declared skills
 			resolved, err := skills.ResolveConfiguredSkills(svc, node, skillsCfg)
 			if err != nil {
-				fmt.Printf("✗ Validation failed: %v\n", err)
-				os.Exit(1)
+				return withExitCode(ExitValidation, fmt.Errorf("validation failed: %w", err))
 			}
 
 			// Print success message with details
@@ -89,7 +137,216 @@ Exit codes:
 				fmt.Printf("  ✓ %s (source: %s, providers: %v)\n", name, r.SourceType, r.Providers)
 			}
 
+			if conflicts := resolvedAliasConflicts(svc, node, resolved); len(conflicts) > 0 {
+				fmt.Println()
+				for _, c := range conflicts {
+					fmt.Printf("  ✗ %s\n", c.Error())
+				}
+				return withExitCode(ExitValidation, fmt.Errorf("%d ambiguous skill alias(es)", len(conflicts)))
+			}
+
+			resolvedList := make([]skills.ResolvedSkill, len(names))
+			for i, name := range names {
+				resolvedList[i] = resolved[name]
+			}
+
+			includeErrs := skills.MapBounded(resolvedList, jobs, validateResolvedIncludes)
+			missingIncludes := 0
+			for i, name := range names {
+				if err := includeErrs[i]; err != nil {
+					if missingIncludes == 0 {
+						fmt.Println()
+					}
+					missingIncludes++
+					fmt.Printf("  ✗ %s: %v\n", name, err)
+				}
+			}
+			if missingIncludes > 0 {
+				return withExitCode(ExitValidation, fmt.Errorf("%d skill(s) reference missing include files", missingIncludes))
+			}
+
+			if strict {
+				strictErrs := skills.MapBounded(resolvedList, jobs, validateResolvedStrict)
+				invalid := 0
+				for i, name := range names {
+					if err := strictErrs[i]; err != nil {
+						if invalid == 0 {
+							fmt.Println()
+						}
+						invalid++
+						fmt.Printf("  ✗ %s: %v\n", name, err)
+					}
+				}
+				if invalid > 0 {
+					return withExitCode(ExitValidation, fmt.Errorf("%d skill(s) failed strict frontmatter validation", invalid))
+				}
+			}
+
+			if schema == nil {
+				return nil
+			}
+
+			fmt.Println()
+			schemaErrs := skills.MapBounded(resolvedList, jobs, func(r skills.ResolvedSkill) error {
+				return validateResolvedAgainstSchema(schema, r)
+			})
+			failed := 0
+			for i, name := range names {
+				if err := schemaErrs[i]; err != nil {
+					failed++
+					fmt.Printf("  ✗ %s: schema validation failed:\n", name)
+					for _, line := range strings.Split(strings.TrimSpace(err.Error()), "\n") {
+						fmt.Printf("      %s\n", line)
+					}
+				}
+			}
+			if failed > 0 {
+				return withExitCode(ExitValidation, fmt.Errorf("%d skill(s) failed schema validation against %s", failed, schemaPath))
+			}
+			fmt.Printf("✓ All skills match schema %s\n", schemaPath)
+
 			return nil
 		},
 	}
+
+	cmd.Flags().StringVar(&schemaPath, "against-schema", "", "Validate skill frontmatter against a JSON Schema file")
+	cmd.Flags().BoolVar(&strict, "strict", false, "Reject unknown frontmatter fields and duplicate keys")
+	cmd.Flags().BoolVar(&recursive, "recursive", false, "Treat each argument as a skills root and validate its subdirectories")
+	cmd.Flags().IntVar(&jobs, "jobs", skills.DefaultJobs(), "Number of skills to check in parallel")
+
+	return cmd
+}
+
+// validateSkillDirs validates the SKILL.md of each skill directory in paths (or,
+// with recursive, of each subdirectory of the paths) and prints the problems
+// grouped by skill. The returned error carries ExitValidation if any skill is
+// invalid.
+func validateSkillDirs(paths []string, recursive, strict bool, schema *skills.FrontmatterSchema, jobs int) error {
+	var dirs []string
+	for _, p := range paths {
+		p = resolvePath(p)
+		if info, err := os.Stat(p); err != nil {
+			return fmt.Errorf("cannot validate %s: %w", p, err)
+		} else if !info.IsDir() {
+			return fmt.Errorf("cannot validate %s: not a directory", p)
+		}
+		if !recursive {
+			dirs = append(dirs, p)
+			continue
+		}
+		entries, err := os.ReadDir(p)
+		if err != nil {
+			return fmt.Errorf("cannot read %s: %w", p, err)
+		}
+		for _, entry := range entries {
+			if entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+				dirs = append(dirs, filepath.Join(p, entry.Name()))
+			}
+		}
+	}
+	if len(dirs) == 0 {
+		fmt.Println("No skill directories found")
+		return nil
+	}
+
+	problems := skills.MapBounded(dirs, jobs, func(dir string) []string {
+		errs := skills.ValidateSkillSource(skills.SkillSource{Path: dir}, filepath.Base(dir))
+		if !strict && schema == nil {
+			return errs
+		}
+		content, err := os.ReadFile(filepath.Join(dir, "SKILL.md")) //nolint:gosec // G304: user-supplied skill directory
+		if err != nil {
+			return errs
+		}
+		if strict {
+			if _, err := skills.ParseSkillFrontmatterStrict(content); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+		if schema != nil {
+			if err := schema.Validate(content); err != nil {
+				errs = append(errs, strings.Split(strings.TrimSpace(err.Error()), "\n")...)
+			}
+		}
+		return errs
+	})
+
+	failed := 0
+	for i, dir := range dirs {
+		if len(problems[i]) == 0 {
+			fmt.Printf("  ✓ %s\n", dir)
+			continue
+		}
+		failed++
+		fmt.Printf("  ✗ %s\n", dir)
+		for _, problem := range problems[i] {
+			fmt.Printf("      - %s\n", problem)
+		}
+	}
+	if failed > 0 {
+		return withExitCode(ExitValidation, fmt.Errorf("%d of %d skill(s) failed validation", failed, len(dirs)))
+	}
+	fmt.Printf("✓ All %d skill(s) are valid\n", len(dirs))
+	return nil
+}
+
+// validateResolvedAgainstSchema loads a resolved skill's SKILL.md and checks
+// its frontmatter against the given schema.
+func validateResolvedAgainstSchema(schema *skills.FrontmatterSchema, r skills.ResolvedSkill) error {
+	loaded, err := skills.LoadSkillFromSource(r.Name, skills.SkillSource{
+		Path:    r.PhysicalPath,
+		RelPath: r.RelPath,
+		Type:    r.SourceType,
+	})
+	if err != nil {
+		return err
+	}
+	content, ok := loaded.Files["SKILL.md"]
+	if !ok {
+		return fmt.Errorf("missing SKILL.md")
+	}
+	return schema.Validate(content)
+}
+
+// validateResolvedStrict strictly decodes a resolved skill's frontmatter.
+func validateResolvedStrict(r skills.ResolvedSkill) error {
+	loaded, err := skills.LoadSkillFromSource(r.Name, skills.SkillSource{
+		Path:    r.PhysicalPath,
+		RelPath: r.RelPath,
+		Type:    r.SourceType,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = skills.ParseSkillFrontmatterStrict(loaded.Files["SKILL.md"])
+	return err
+}
+
+// validateResolvedIncludes checks that every file in a resolved skill's
+// "includes" frontmatter exists in the skill.
+func validateResolvedIncludes(r skills.ResolvedSkill) error {
+	loaded, err := skills.LoadSkillFromSource(r.Name, skills.SkillSource{
+		Path:    r.PhysicalPath,
+		RelPath: r.RelPath,
+		Type:    r.SourceType,
+	})
+	if err != nil {
+		return err
+	}
+	return skills.ValidateSkillIncludes(loaded.Files)
+}
+
+// resolvedAliasConflicts returns the alias conflicts that involve at least one
+// resolved skill, ignoring conflicts among skills the workspace doesn't use.
+func resolvedAliasConflicts(svc *service.Service, node *workspace.WorkspaceNode, resolved map[string]skills.ResolvedSkill) []skills.AliasConflict {
+	var conflicts []skills.AliasConflict
+	for _, c := range skills.AliasConflicts(skills.ListSkillSources(svc, node)) {
+		for _, owner := range c.Skills {
+			if _, ok := resolved[owner]; ok {
+				conflicts = append(conflicts, c)
+				break
+			}
+		}
+	}
+	return conflicts
 }
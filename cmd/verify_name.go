@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grovetools/skills/pkg/skills"
+	"github.com/spf13/cobra"
+)
+
+// nameVerification is the --json output of verify-name.
+type nameVerification struct {
+	Name   string   `json:"name"`
+	Valid  bool     `json:"valid"`
+	Errors []string `json:"errors"`
+}
+
+func newSkillsVerifyNameCmd() *cobra.Command {
+	var jsonOutput bool
+	cmd := &cobra.Command{
+		Use:   "verify-name <name>",
+		Short: "Check whether a proposed skill name is valid",
+		Long: `Check a proposed skill name against the naming rules applied by validate:
+lowercase alphanumeric words separated by single hyphens, at most 64
+characters. Nothing is read from disk, so editors and other tooling can call it
+instead of reimplementing the rules.
+
+Exits 0 if the name is valid and 2 if it is not, printing the reasons. With
+--json the result is printed as {"name", "valid", "errors"} in either case.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			errs := skills.ValidateSkillName(name)
+
+			if jsonOutput {
+				out, err := marshalEnvelope("result", nameVerification{Name: name, Valid: len(errs) == 0, Errors: append([]string{}, errs...)})
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(out))
+				if len(errs) > 0 {
+					return withExitCode(ExitValidation, fmt.Errorf("invalid skill name '%s'", name))
+				}
+				return nil
+			}
+
+			if len(errs) > 0 {
+				return withExitCode(ExitValidation, fmt.Errorf("invalid skill name '%s': %s", name, strings.Join(errs, "; ")))
+			}
+			fmt.Printf("'%s' is a valid skill name\n", name)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Print the result as JSON.")
+	return cmd
+}
@@ -1,27 +1,53 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/grovetools/core/logging"
 	"github.com/grovetools/core/version"
+	"github.com/grovetools/skills/pkg/skills"
 	"github.com/spf13/cobra"
 )
 
 var versionUlog = logging.NewUnifiedLogger("grove-skills")
 
 func newVersionCmd() *cobra.Command {
-	var jsonOutput bool
+	var jsonOutput, skillsDigest bool
 
 	cmd := &cobra.Command{
 		Use:   "version",
 		Short: "Print the version information for this binary",
+		Long: `Print the version information for this binary.
+
+Use --skills-digest to print only a SHA-256 digest of the builtin skills
+embedded in the binary. It depends solely on their files, so tools can compare
+it across binaries to tell whether an upgrade changed the builtins and a
+re-sync is worthwhile. With --json it is added to the version object as
+"skillsDigest".`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			info := version.GetInfo()
 
+			var digest string
+			if skillsDigest {
+				var err error
+				if digest, err = skills.BuiltinSkillsDigest(); err != nil {
+					return err
+				}
+				if !jsonOutput {
+					fmt.Println(digest)
+					return nil
+				}
+			}
+
 			if jsonOutput {
-				jsonData, err := json.MarshalIndent(info, "", "  ")
+				var payload any = info
+				if skillsDigest {
+					payload = struct {
+						version.Info
+						SkillsDigest string `json:"skillsDigest"`
+					}{info, digest}
+				}
+				jsonData, err := marshalEnvelope("version", payload)
 				if err != nil {
 					return fmt.Errorf("failed to marshal version info to JSON: %w", err)
 				}
@@ -44,6 +70,7 @@ func newVersionCmd() *cobra.Command {
 	}
 
 	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output version information in JSON format")
+	cmd.Flags().BoolVar(&skillsDigest, "skills-digest", false, "Print a digest of the embedded builtin skills")
 
 	return cmd
 }
@@ -1,6 +1,9 @@
 package service
 
 import (
+	"context"
+	"path/filepath"
+
 	coreconfig "github.com/grovetools/core/config"
 	"github.com/grovetools/core/pkg/workspace"
 	"github.com/sirupsen/logrus"
@@ -14,15 +17,70 @@ type Service struct {
 	NotebookLocator *workspace.NotebookLocator
 	Config          *coreconfig.Config
 	Logger          *logrus.Entry
+
+	// EmbeddedOnly restricts skill discovery to the builtin skills embedded
+	// in the binary (--embedded-only).
+	EmbeddedOnly bool
+
+	// NoFollowSymlinks stops skill discovery from descending into symlinked
+	// directories (--no-follow-symlinks).
+	NoFollowSymlinks bool
+
+	// Notebook, when set, names the notebook definition notebook skills are
+	// resolved from instead of the one config selects (--notebook).
+	Notebook string
+
+	// ctx bounds long-running or network-backed work started through the
+	// service. It is cancelled when --timeout expires.
+	ctx context.Context
 }
 
 // New creates a new service instance.
 func New(provider *workspace.Provider, cfg *coreconfig.Config, logger *logrus.Entry) (*Service, error) {
+	return NewWithContext(context.Background(), provider, cfg, logger)
+}
+
+// NewWithContext creates a new service instance bound to ctx.
+func NewWithContext(ctx context.Context, provider *workspace.Provider, cfg *coreconfig.Config, logger *logrus.Entry) (*Service, error) {
 	locator := workspace.NewNotebookLocator(cfg)
 	return &Service{
 		Provider:        provider,
 		NotebookLocator: locator,
 		Config:          cfg,
 		Logger:          logger,
+		ctx:             ctx,
 	}, nil
 }
+
+// Context returns the context operations should honor for cancellation.
+// It never returns nil.
+func (s *Service) Context() context.Context {
+	if s == nil || s.ctx == nil {
+		return context.Background()
+	}
+	return s.ctx
+}
+
+// ResolveNode returns the workspace node containing path. It walks up from
+// path looking for a workspace root and, if none is found, falls back to the
+// discovered workspaces known to the Provider. All commands resolve the
+// current directory through here so they agree on the node. A nil Service
+// only performs the filesystem lookup.
+func (s *Service) ResolveNode(path string) (*workspace.WorkspaceNode, error) {
+	node, err := workspace.GetProjectByPath(path)
+	if err == nil {
+		return node, nil
+	}
+	if s == nil || s.Provider == nil {
+		return nil, err
+	}
+	if absPath, absErr := filepath.Abs(path); absErr == nil {
+		if found := s.Provider.FindByPath(absPath); found != nil {
+			if s.Logger != nil {
+				s.Logger.WithField("path", absPath).Debugf("resolved workspace %s from discovery", found.Name)
+			}
+			return found, nil
+		}
+	}
+	return nil, err
+}
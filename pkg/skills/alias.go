@@ -0,0 +1,93 @@
+package skills
+
+import (
+	"fmt"
+	"sort"
+)
+
+// SkillAliases maps every alias declared in the frontmatter of the given skill
+// sources to its canonical skill name. Aliases that shadow a canonical name or
+// are claimed by more than one skill are ambiguous and left out; see
+// AliasConflicts.
+func SkillAliases(sources map[string]SkillSource) map[string]string {
+	claims := aliasClaims(sources)
+	aliases := make(map[string]string, len(claims))
+	for alias, owners := range claims {
+		if _, isSkill := sources[alias]; isSkill || len(owners) > 1 {
+			continue
+		}
+		aliases[alias] = owners[0]
+	}
+	return aliases
+}
+
+// AliasConflict is an alias that cannot be resolved unambiguously: it names
+// an existing skill, or more than one skill declares it.
+type AliasConflict struct {
+	Alias string
+	// Skills are the skills declaring the alias, sorted.
+	Skills []string
+	// CollidesWithSkill is set when Alias is itself a canonical skill name.
+	CollidesWithSkill bool
+}
+
+func (c AliasConflict) Error() string {
+	if c.CollidesWithSkill {
+		return fmt.Sprintf("alias '%s' declared by %v collides with skill '%s'", c.Alias, c.Skills, c.Alias)
+	}
+	return fmt.Sprintf("alias '%s' is declared by multiple skills: %v", c.Alias, c.Skills)
+}
+
+// AliasConflicts returns every ambiguous alias among the given skill sources,
+// sorted by alias.
+func AliasConflicts(sources map[string]SkillSource) []AliasConflict {
+	claims := aliasClaims(sources)
+	aliases := make([]string, 0, len(claims))
+	for alias := range claims {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	var conflicts []AliasConflict
+	for _, alias := range aliases {
+		owners := claims[alias]
+		_, isSkill := sources[alias]
+		if isSkill || len(owners) > 1 {
+			conflicts = append(conflicts, AliasConflict{Alias: alias, Skills: owners, CollidesWithSkill: isSkill})
+		}
+	}
+	return conflicts
+}
+
+// lookupSkillSource finds name in sources, falling back to skill aliases.
+// It returns the canonical skill name along with its source.
+func lookupSkillSource(sources map[string]SkillSource, name string) (string, SkillSource, bool) {
+	if src, ok := sources[name]; ok {
+		return name, src, true
+	}
+	if canonical, ok := SkillAliases(sources)[name]; ok {
+		return canonical, sources[canonical], true
+	}
+	return "", SkillSource{}, false
+}
+
+// aliasClaims maps each declared alias to the sorted names of the skills
+// declaring it.
+func aliasClaims(sources map[string]SkillSource) map[string][]string {
+	claims := make(map[string][]string)
+	for name, src := range sources {
+		meta, err := ReadSkillMetadata(src)
+		if err != nil {
+			continue
+		}
+		for _, alias := range meta.Aliases {
+			if alias != "" && alias != name {
+				claims[alias] = append(claims[alias], name)
+			}
+		}
+	}
+	for _, owners := range claims {
+		sort.Strings(owners)
+	}
+	return claims
+}
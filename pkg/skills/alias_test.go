@@ -0,0 +1,44 @@
+package skills
+
+import "testing"
+
+func TestSkillAliases(t *testing.T) {
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+
+	writeUserSkill(t, configHome, "code-review", "aliases: [review, cr]\n")
+	writeUserSkill(t, configHome, "pr-review", "aliases: [review]\n")
+	writeUserSkill(t, configHome, "cr", "")
+
+	sources := ListSkillSources(nil, nil)
+	name, src, ok := lookupSkillSource(sources, "code-review")
+	if !ok || name != "code-review" || src.Type != SourceTypeUser {
+		t.Errorf("expected canonical lookup to succeed, got %q %+v %v", name, src, ok)
+	}
+
+	// "review" is claimed twice and "cr" names another skill, so neither
+	// alias may resolve.
+	if name, _, ok := lookupSkillSource(sources, "review"); ok {
+		t.Errorf("expected ambiguous alias to stay unresolved, got %q", name)
+	}
+	if name, _, _ := lookupSkillSource(sources, "cr"); name != "cr" {
+		t.Errorf("expected skill 'cr' to win over alias, got %q", name)
+	}
+
+	conflicts := AliasConflicts(sources)
+	if len(conflicts) != 2 {
+		t.Fatalf("expected 2 conflicts, got %v", conflicts)
+	}
+	if conflicts[0].Alias != "cr" || !conflicts[0].CollidesWithSkill {
+		t.Errorf("unexpected first conflict: %+v", conflicts[0])
+	}
+	if conflicts[1].Alias != "review" || len(conflicts[1].Skills) != 2 {
+		t.Errorf("unexpected second conflict: %+v", conflicts[1])
+	}
+
+	writeUserSkill(t, configHome, "lint", "aliases: [linter]\n")
+	sources = ListSkillSources(nil, nil)
+	if name, _, ok := lookupSkillSource(sources, "linter"); !ok || name != "lint" {
+		t.Errorf("expected alias 'linter' to resolve to 'lint', got %q (found=%v)", name, ok)
+	}
+}
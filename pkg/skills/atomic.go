@@ -0,0 +1,267 @@
+package skills
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"syscall"
+
+	corefs "github.com/grovetools/core/fs"
+	"github.com/grovetools/core/logging"
+)
+
+// syncConfiguredSkillsAtomic is syncConfiguredSkills for opts.Atomic: each
+// provider skills directory in gitRoot and its worktrees is rebuilt in a
+// staging directory and swapped into place, so readers never see a partially
+// synced directory. If any skill fails to install, that directory is left
+// untouched. Once ctx is done no further directory is swapped.
+func syncConfiguredSkillsAtomic(ctx context.Context, gitRoot string, resolved map[string]ResolvedSkill, only map[string]bool, opts SyncOptions, logger *logging.PrettyLogger) (int, []string, []SyncError) {
+	perProvider := make(map[string]map[string]ResolvedSkill)
+	for name, r := range resolved {
+		for _, provider := range r.Providers {
+			if perProvider[provider] == nil {
+				perProvider[provider] = make(map[string]ResolvedSkill)
+			}
+			perProvider[provider][name] = r
+		}
+	}
+	providers := make([]string, 0, len(perProvider))
+	for provider := range perProvider {
+		providers = append(providers, provider)
+	}
+	sort.Strings(providers)
+
+	syncedCount := 0
+	var pruned []string
+	var errs []SyncError
+	for _, root := range syncRoots(gitRoot) {
+		for _, provider := range providers {
+			if err := ctx.Err(); err != nil {
+				names := make([]string, 0, len(perProvider[provider]))
+				for name := range perProvider[provider] {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+				for _, name := range names {
+					errs = append(errs, abortedSyncError(name, err))
+				}
+				return syncedCount, pruned, errs
+			}
+			n, p, e := swapSkillsDir(GetSkillsDirectoryForWorktree(root, provider), perProvider[provider], only, opts, logger)
+			if root == gitRoot {
+				syncedCount += n
+			}
+			pruned = append(pruned, p...)
+			errs = append(errs, e...)
+		}
+	}
+	return syncedCount, pruned, errs
+}
+
+// swapSkillsDir rebuilds the skills directory base in a sibling staging
+// directory and swaps it in. Entries that aren't being written are carried
+// over, except unconfigured skills when opts.Prune is set. It returns the
+// number of skills written and the paths pruned.
+func swapSkillsDir(base string, skills map[string]ResolvedSkill, only map[string]bool, opts SyncOptions, logger *logging.PrettyLogger) (int, []string, []SyncError) {
+	names := make([]string, 0, len(skills))
+	for name := range skills {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	failAll := func(err error) []SyncError {
+		errs := make([]SyncError, 0, len(names))
+		for _, name := range names {
+			errs = append(errs, SyncError{Skill: name, Phase: SyncPhaseWrite, Err: err})
+		}
+		return errs
+	}
+
+	if err := ensureSkillsBaseDir(base, opts); err != nil {
+		return 0, nil, failAll(err)
+	}
+	// Swap the real directory when base is a symlink, and stage next to it so
+	// the renames stay on one filesystem.
+	target := base
+	if resolved, err := filepath.EvalSymlinks(base); err == nil {
+		target = resolved
+	}
+	info, err := os.Stat(target)
+	if err != nil {
+		return 0, nil, failAll(err)
+	}
+	stage, err := os.MkdirTemp(filepath.Dir(target), "."+filepath.Base(target)+".sync-*")
+	if err != nil {
+		return 0, nil, failAll(fmt.Errorf("failed to create staging directory: %w", err))
+	}
+	defer func() { _ = RemoveSkillDir(stage) }()
+	if err := os.Chmod(stage, info.Mode().Perm()); err != nil {
+		return 0, nil, failAll(err)
+	}
+
+	entries, err := os.ReadDir(target)
+	if err != nil {
+		return 0, nil, failAll(err)
+	}
+	var pruned []string
+	for _, entry := range entries {
+		name := entry.Name()
+		_, configured := skills[name]
+		rewritten := configured && (only == nil || only[name])
+		isSkill := entry.IsDir() || entry.Type()&fs.ModeSymlink != 0
+		if rewritten && !opts.Merge {
+			continue
+		}
+		if !configured && isSkill && opts.Prune {
+			pruned = append(pruned, filepath.Join(base, name))
+			continue
+		}
+		if err := copyTree(filepath.Join(target, name), filepath.Join(stage, name)); err != nil {
+			return 0, nil, failAll(fmt.Errorf("failed to stage %s: %w", filepath.Join(base, name), err))
+		}
+	}
+
+	written := 0
+	var errs []SyncError
+	for _, name := range names {
+		if only != nil && !only[name] {
+			continue
+		}
+		if err := installResolvedSkill(skills[name], filepath.Join(stage, name), opts); err != nil {
+			errs = append(errs, SyncError{Skill: name, Phase: SyncPhaseWrite, Err: err})
+			continue
+		}
+		written++
+	}
+	if len(errs) > 0 {
+		return 0, nil, errs
+	}
+
+	if err := swapDir(stage, target); err != nil {
+		if !isCrossDeviceError(err) {
+			return 0, nil, failAll(fmt.Errorf("failed to swap in %s: %w", base, err))
+		}
+		// A mount point can't be renamed; fall back to replacing its contents.
+		if logger != nil {
+			logger.WarnPretty(fmt.Sprintf("Could not swap %s atomically (%v); updating it in place", base, err))
+		}
+		if err := replaceDirContents(stage, target); err != nil {
+			return 0, nil, failAll(fmt.Errorf("failed to update %s: %w", base, err))
+		}
+	}
+	if logger != nil {
+		for _, path := range pruned {
+			logger.InfoPretty(fmt.Sprintf("Pruned unconfigured skill at: %s", path))
+		}
+	}
+	return written, pruned, nil
+}
+
+// swapDir replaces target with stage: target is renamed to a backup, stage is
+// renamed into place and the backup is deleted. If the second rename fails the
+// backup is restored.
+func swapDir(stage, target string) error {
+	backup := stage + ".old"
+	if err := os.Rename(target, backup); err != nil {
+		return err
+	}
+	if err := os.Rename(stage, target); err != nil {
+		_ = os.Rename(backup, target)
+		return err
+	}
+	_ = RemoveSkillDir(backup)
+	return nil
+}
+
+// isCrossDeviceError reports whether a rename failed because source and
+// destination are on different filesystems or the path is a mount point.
+func isCrossDeviceError(err error) bool {
+	return errors.Is(err, syscall.EXDEV) || errors.Is(err, syscall.EBUSY)
+}
+
+// replaceDirContents makes dir's entries match stage's by removing and
+// copying entries one at a time. It is the non-atomic fallback of swapDir.
+func replaceDirContents(stage, dir string) error {
+	staged, err := os.ReadDir(stage)
+	if err != nil {
+		return err
+	}
+	keep := make(map[string]bool, len(staged))
+	for _, entry := range staged {
+		keep[entry.Name()] = true
+	}
+	current, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range current {
+		if !keep[entry.Name()] {
+			if err := RemoveSkillDir(filepath.Join(dir, entry.Name())); err != nil {
+				return err
+			}
+		}
+	}
+	for _, entry := range staged {
+		dst := filepath.Join(dir, entry.Name())
+		if err := RemoveSkillDir(dst); err != nil {
+			return err
+		}
+		if err := copyTree(filepath.Join(stage, entry.Name()), dst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyTree copies the file, directory or symlink at src to dst. Symlinks are
+// recreated rather than followed, and directory permissions are applied after
+// their contents are written so read-only installs can be copied.
+func copyTree(src, dst string) error {
+	type dirMode struct {
+		path string
+		mode fs.FileMode
+	}
+	var dirs []dirMode
+	err := filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		switch {
+		case d.Type()&fs.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case d.IsDir():
+			dirs = append(dirs, dirMode{target, info.Mode().Perm()})
+			return os.MkdirAll(target, 0o755) //nolint:gosec // G301: final mode applied below
+		default:
+			if err := corefs.CopyFile(path, target); err != nil {
+				return err
+			}
+			return os.Chmod(target, info.Mode().Perm())
+		}
+	})
+	if err != nil {
+		return err
+	}
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if err := os.Chmod(dirs[i].path, dirs[i].mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package skills
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSyncConfiguredSkillsAtomic(t *testing.T) {
+	root := t.TempDir()
+	base := GetSkillsDirectoryForWorktree(root, "claude")
+	old := filepath.Join(base, "old-skill")
+	if err := os.MkdirAll(old, 0o755); err != nil { //nolint:gosec // G301: test
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(old, "SKILL.md"), []byte("old\n"), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+	src := writeUserSkill(t, t.TempDir(), "new-skill", "")
+	resolved := map[string]ResolvedSkill{
+		"new-skill": {Name: "new-skill", SourceType: SourceTypeUser, PhysicalPath: src, Providers: []string{"claude"}},
+	}
+
+	n, pruned, errs := syncConfiguredSkills(context.Background(), root, resolved, nil, SyncOptions{Atomic: true}, nil)
+	if n != 1 || len(pruned) != 0 || len(errs) != 0 {
+		t.Fatalf("sync = %d, %v, %v", n, pruned, errs)
+	}
+	for _, path := range []string{filepath.Join(base, "new-skill", "SKILL.md"), filepath.Join(old, "SKILL.md")} {
+		if _, err := os.Stat(path); err != nil {
+			t.Errorf("expected %s after atomic sync: %v", path, err)
+		}
+	}
+
+	// A failing skill leaves the directory untouched, even with --prune.
+	broken := map[string]ResolvedSkill{
+		"new-skill":    resolved["new-skill"],
+		"broken-skill": {Name: "broken-skill", SourceType: SourceTypeUser, PhysicalPath: filepath.Join(root, "missing"), Providers: []string{"claude"}},
+	}
+	if _, _, errs := syncConfiguredSkills(context.Background(), root, broken, nil, SyncOptions{Atomic: true, Prune: true}, nil); len(errs) == 0 {
+		t.Fatal("expected an error for the broken skill")
+	}
+	if _, err := os.Stat(old); err != nil {
+		t.Error("failed atomic sync pruned old-skill")
+	}
+
+	_, pruned, errs = syncConfiguredSkills(context.Background(), root, resolved, nil, SyncOptions{Atomic: true, Prune: true}, nil)
+	if len(errs) != 0 || len(pruned) != 1 || pruned[0] != old {
+		t.Fatalf("prune = %v, %v; want [%s]", pruned, errs, old)
+	}
+	if _, err := os.Stat(old); !os.IsNotExist(err) {
+		t.Error("expected old-skill to be pruned")
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(base))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		if strings.Contains(e.Name(), ".sync-") {
+			t.Errorf("staging directory left behind: %s", e.Name())
+		}
+	}
+}
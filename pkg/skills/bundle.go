@@ -0,0 +1,157 @@
+package skills
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// BundleManifestName is the manifest file at the root of a skills bundle.
+const BundleManifestName = "manifest.json"
+
+// BundleManifest describes the skills packaged in a bundle.
+type BundleManifest struct {
+	Skills []BundleManifestEntry `json:"skills"`
+}
+
+// bundleModTime is the fixed timestamp stamped on every archive entry so that
+// bundling the same skills always produces byte-identical output.
+var bundleModTime = time.Unix(0, 0).UTC()
+
+// BundleManifestEntry describes a single skill in a bundle. Version is the
+// SHA-256 of the skill's files, so identical content always has the same version.
+type BundleManifestEntry struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Source      string   `json:"source"`
+	Version     string   `json:"version"`
+	Files       []string `json:"files"`
+}
+
+// WriteBundle writes the given skills as a gzipped tar archive to w. Each skill
+// is stored under skills/<name>/ and a manifest.json lists every skill.
+// Output is deterministic: skills and files are sorted and timestamps fixed.
+func WriteBundle(w io.Writer, loaded []*LoadedSkill) error {
+	sorted := append([]*LoadedSkill(nil), loaded...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	var manifest BundleManifest
+	for _, skill := range sorted {
+		entry := BundleManifestEntry{
+			Name:    skill.Name,
+			Source:  string(skill.SourceType),
+			Files:   slashFileNames(skill.Files),
+			Version: skillDigest(skill.Files),
+		}
+		if meta, err := ParseSkillFrontmatter(skill.Files["SKILL.md"]); err == nil {
+			entry.Description = meta.Description
+		}
+		manifest.Skills = append(manifest.Skills, entry)
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode bundle manifest: %w", err)
+	}
+	if err := writeTarFile(tw, BundleManifestName, manifestData); err != nil {
+		return err
+	}
+
+	for _, skill := range sorted {
+		for _, name := range sortedFileKeys(skill.Files) {
+			archivePath := path.Join("skills", skill.Name, filepath.ToSlash(name))
+			if err := writeTarFile(tw, archivePath, skill.Files[name]); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to finalize bundle: %w", err)
+	}
+	return gz.Close()
+}
+
+// writeTarFile adds a single regular file entry to tw with fixed metadata.
+func writeTarFile(tw *tar.Writer, name string, content []byte) error {
+	hdr := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0o644,
+		Size:     int64(len(content)),
+		ModTime:  bundleModTime,
+		Format:   tar.FormatPAX,
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("failed to write %s to bundle: %w", name, err)
+	}
+	if _, err := tw.Write(content); err != nil {
+		return fmt.Errorf("failed to write %s to bundle: %w", name, err)
+	}
+	return nil
+}
+
+// sortedFileKeys returns the keys of files ordered by their slash-separated form,
+// so archive order does not depend on the host OS.
+func sortedFileKeys(files map[string][]byte) []string {
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return filepath.ToSlash(keys[i]) < filepath.ToSlash(keys[j]) })
+	return keys
+}
+
+// slashFileNames returns the sorted slash-separated relative paths of files.
+func slashFileNames(files map[string][]byte) []string {
+	keys := sortedFileKeys(files)
+	for i, key := range keys {
+		keys[i] = filepath.ToSlash(key)
+	}
+	return keys
+}
+
+// SourceDigest returns the content digest of a discovered skill, the same
+// value recorded as the skill's version in bundle manifests.
+func SourceDigest(src SkillSource) (string, error) {
+	files, err := readSkillSourceFiles(src)
+	if err != nil {
+		return "", err
+	}
+	return skillDigest(files), nil
+}
+
+// BuiltinSkillsDigest returns a digest of the whole embedded data/skills tree,
+// computed like skillDigest over paths relative to it. Two binaries with the
+// same digest ship identical builtin skills, so tools can compare it across
+// versions to decide whether builtins need re-syncing.
+func BuiltinSkillsDigest() (string, error) {
+	files, err := readSkillFromFS(embeddedSkillsFS, ".")
+	if err != nil {
+		return "", fmt.Errorf("failed to read embedded skills: %w", err)
+	}
+	return skillDigest(files), nil
+}
+
+// skillDigest returns a stable SHA-256 over a skill's file paths and content.
+func skillDigest(files map[string][]byte) string {
+	h := sha256.New()
+	for _, key := range sortedFileKeys(files) {
+		_, _ = io.WriteString(h, filepath.ToSlash(key))
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write(files[key])
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
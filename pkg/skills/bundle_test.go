@@ -0,0 +1,112 @@
+package skills
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"testing"
+)
+
+func TestWriteBundle(t *testing.T) {
+	loaded := []*LoadedSkill{
+		{
+			Name:       "beta",
+			SourceType: SourceTypeUser,
+			Files: map[string][]byte{
+				"SKILL.md":      []byte("---\nname: beta\ndescription: Beta skill\n---\n"),
+				"refs/notes.md": []byte("notes"),
+			},
+		},
+		{
+			Name:       "alpha",
+			SourceType: SourceTypeBuiltin,
+			Files:      map[string][]byte{"SKILL.md": []byte("---\nname: alpha\ndescription: Alpha skill\n---\n")},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteBundle(&buf, loaded); err != nil {
+		t.Fatalf("WriteBundle: %v", err)
+	}
+
+	gz, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gz)
+
+	var names []string
+	var manifest BundleManifest
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, hdr.Name)
+		if hdr.Name == BundleManifestName {
+			if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+				t.Fatalf("decode manifest: %v", err)
+			}
+		}
+	}
+
+	want := []string{"manifest.json", "skills/alpha/SKILL.md", "skills/beta/SKILL.md", "skills/beta/refs/notes.md"}
+	if len(names) != len(want) {
+		t.Fatalf("archive entries = %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("entry %d = %q, want %q", i, names[i], want[i])
+		}
+	}
+
+	if len(manifest.Skills) != 2 || manifest.Skills[0].Name != "alpha" || manifest.Skills[1].Description != "Beta skill" {
+		t.Errorf("unexpected manifest: %+v", manifest.Skills)
+	}
+	if manifest.Skills[0].Version == "" || manifest.Skills[0].Version == manifest.Skills[1].Version {
+		t.Errorf("expected distinct content versions, got %+v", manifest.Skills)
+	}
+}
+
+func TestWriteBundle_Deterministic(t *testing.T) {
+	makeSkills := func() []*LoadedSkill {
+		files := make(map[string][]byte)
+		for _, name := range []string{"SKILL.md", "a.md", "refs/b.md", "refs/c.md", "z.md"} {
+			files[name] = []byte("content of " + name)
+		}
+		return []*LoadedSkill{
+			{Name: "two", SourceType: SourceTypeUser, Files: files},
+			{Name: "one", SourceType: SourceTypeUser, Files: map[string][]byte{"SKILL.md": []byte("one")}},
+		}
+	}
+
+	var first, second bytes.Buffer
+	if err := WriteBundle(&first, makeSkills()); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteBundle(&second, makeSkills()); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(first.Bytes(), second.Bytes()) {
+		t.Error("expected two bundles of the same skills to be byte-identical")
+	}
+}
+
+func TestBuiltinSkillsDigest(t *testing.T) {
+	first, err := BuiltinSkillsDigest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := BuiltinSkillsDigest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second || len(first) != 64 {
+		t.Errorf("digest = %q then %q, want the same 64-character hex digest", first, second)
+	}
+}
@@ -0,0 +1,80 @@
+package skills
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CanonicalizeSkillContent re-emits the frontmatter of a SKILL.md in
+// canonical form: YAML, the known SkillMetadata fields in declaration order
+// (empty optional fields omitted), then any other fields sorted by key, all
+// with consistent quoting and two-space indentation. TOML and JSON
+// frontmatter is converted to YAML. The body is preserved byte for byte.
+func CanonicalizeSkillContent(content []byte) ([]byte, error) {
+	frontmatter, body, format, err := splitSkillFrontmatter(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var meta SkillMetadata
+	if err := unmarshalFrontmatter(frontmatter, format, &meta); err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err := unmarshalFrontmatter(frontmatter, format, &fields); err != nil {
+		return nil, err
+	}
+
+	var doc yaml.Node
+	if err := doc.Encode(&meta); err != nil {
+		return nil, err
+	}
+	known := knownFrontmatterKeys()
+	extra := make([]string, 0, len(fields))
+	for key := range fields {
+		if !known[key] {
+			extra = append(extra, key)
+		}
+	}
+	sort.Strings(extra)
+	for _, key := range extra {
+		var keyNode, valueNode yaml.Node
+		if err := keyNode.Encode(key); err != nil {
+			return nil, err
+		}
+		if err := valueNode.Encode(fields[key]); err != nil {
+			return nil, fmt.Errorf("frontmatter field '%s': %w", key, err)
+		}
+		doc.Content = append(doc.Content, &keyNode, &valueNode)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	buf.WriteString("---\n")
+	buf.Write(body)
+	return buf.Bytes(), nil
+}
+
+// knownFrontmatterKeys returns the frontmatter keys SkillMetadata decodes.
+func knownFrontmatterKeys() map[string]bool {
+	keys := make(map[string]bool)
+	t := reflect.TypeOf(SkillMetadata{})
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ",")
+		keys[name] = true
+	}
+	return keys
+}
@@ -0,0 +1,33 @@
+package skills
+
+import "testing"
+
+func TestCanonicalizeSkillContent(t *testing.T) {
+	body := "\n# Title\n\n  indented body kept as-is  \n"
+	in := "---\naliases: [b-alias]\nowner:   team-x\ndescription: 'Does things: well'\nname: my-skill\n---" + body
+
+	want := "---\nname: my-skill\ndescription: 'Does things: well'\naliases:\n  - b-alias\nowner: team-x\n---" + body
+	got, err := CanonicalizeSkillContent([]byte(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != want {
+		t.Errorf("CanonicalizeSkillContent() =\n%s\nwant:\n%s", got, want)
+	}
+
+	again, err := CanonicalizeSkillContent(got)
+	if err != nil || string(again) != string(got) {
+		t.Errorf("expected canonical form to be stable, got:\n%s", again)
+	}
+}
+
+func TestCanonicalizeSkillContent_TOML(t *testing.T) {
+	in := "+++\ndescription = \"A skill\"\nname = \"toml-skill\"\n+++\nBody\n"
+	got, err := CanonicalizeSkillContent([]byte(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "---\nname: toml-skill\ndescription: A skill\n---\nBody\n"; string(got) != want {
+		t.Errorf("CanonicalizeSkillContent() =\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -43,6 +43,14 @@ type SkillsConfig struct {
 	// Used in global config (~/.config/grove/grove.toml) to define
 	// ecosystem-specific skills that live in dotfiles rather than repo config.
 	Ecosystems map[string]*SkillsConfig `toml:"ecosystems" yaml:"ecosystems"`
+
+	// DefaultScope and DefaultProvider replace the built-in defaults of the
+	// --scope and --provider flags. Explicit flags still take precedence.
+	DefaultScope    string `toml:"default_scope" yaml:"default_scope"`
+	DefaultProvider string `toml:"default_provider" yaml:"default_provider"`
+
+	// Profiles defines named source selections usable with --profile.
+	Profiles map[string]SourceProfile `toml:"profiles" yaml:"profiles"`
 }
 
 // groveTomlSkills is used to extract the skills block from grove.toml
@@ -140,7 +148,8 @@ func loadSkillsFromGlobalConfig(cfg *coreconfig.Config) *SkillsConfig {
 	// Return nil if nothing was configured
 	if len(result.Use) == 0 && len(result.Providers) == 0 &&
 		len(result.Dependencies) == 0 && len(result.Projects) == 0 &&
-		len(result.Ecosystems) == 0 {
+		len(result.Ecosystems) == 0 && result.DefaultScope == "" &&
+		result.DefaultProvider == "" && len(result.Profiles) == 0 {
 		return nil
 	}
 
@@ -206,6 +215,9 @@ func mergeSkillsConfig(ecosystem, project *SkillsConfig) *SkillsConfig {
 
 		// Deep merge dependencies (project overrides ecosystem)
 		Dependencies: make(map[string]DependencyConfig),
+
+		// Profiles are merged by name (project overrides ecosystem)
+		Profiles: make(map[string]SourceProfile),
 	}
 
 	// If project didn't specify providers, use ecosystem's
@@ -222,6 +234,13 @@ func mergeSkillsConfig(ecosystem, project *SkillsConfig) *SkillsConfig {
 		merged.Dependencies[k] = v
 	}
 
+	for k, v := range ecosystem.Profiles {
+		merged.Profiles[k] = v
+	}
+	for k, v := range project.Profiles {
+		merged.Profiles[k] = v
+	}
+
 	return merged
 }
 
@@ -232,9 +251,12 @@ func copySkillsConfig(cfg *SkillsConfig) *SkillsConfig {
 	}
 
 	copied := &SkillsConfig{
-		Use:          make([]string, len(cfg.Use)),
-		Providers:    make([]string, len(cfg.Providers)),
-		Dependencies: make(map[string]DependencyConfig),
+		Use:             make([]string, len(cfg.Use)),
+		Providers:       make([]string, len(cfg.Providers)),
+		Dependencies:    make(map[string]DependencyConfig),
+		Profiles:        make(map[string]SourceProfile),
+		DefaultScope:    cfg.DefaultScope,
+		DefaultProvider: cfg.DefaultProvider,
 	}
 
 	copy(copied.Use, cfg.Use)
@@ -243,6 +265,9 @@ func copySkillsConfig(cfg *SkillsConfig) *SkillsConfig {
 	for k, v := range cfg.Dependencies {
 		copied.Dependencies[k] = v
 	}
+	for k, v := range cfg.Profiles {
+		copied.Profiles[k] = v
+	}
 
 	return copied
 }
@@ -0,0 +1,77 @@
+package skills
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+)
+
+// ScopeAdmin is the system-wide install scope, rooted at /etc.
+const ScopeAdmin = "admin"
+
+// DestinationResolver maps an install scope's base directory (the home
+// directory, a project, an ecosystem root...) to where one provider keeps its
+// skills inside it.
+type DestinationResolver interface {
+	// SkillsDir returns the provider's skills directory under base for the
+	// given scope, or an error if the provider doesn't support the scope.
+	SkillsDir(base, scope string) (string, error)
+}
+
+// dotDirLayout is the layout shared by the built-in providers: skills live in
+// <base>/<Dir>/<Sub>.
+type dotDirLayout struct {
+	Dir, Sub string
+	// AdminDir replaces Dir in the admin scope (e.g. /etc/codex/skills).
+	// Providers without one don't support the admin scope.
+	AdminDir string
+}
+
+func (l dotDirLayout) SkillsDir(base, scope string) (string, error) {
+	if scope != ScopeAdmin {
+		return filepath.Join(base, l.Dir, l.Sub), nil
+	}
+	if l.AdminDir == "" {
+		return "", fmt.Errorf("'%s' scope is not supported for this provider", ScopeAdmin)
+	}
+	return filepath.Join(base, l.AdminDir, l.Sub), nil
+}
+
+// destinationResolvers holds the DestinationResolver of every supported
+// provider, keyed by canonical provider name.
+var destinationResolvers = map[string]DestinationResolver{
+	"claude":   dotDirLayout{Dir: ".claude", Sub: "skills"},
+	"codex":    dotDirLayout{Dir: ".codex", Sub: "skills", AdminDir: "codex"},
+	"opencode": dotDirLayout{Dir: ".opencode", Sub: "skill"},
+}
+
+// RegisterDestinationResolver adds or replaces the resolver for a provider.
+// It is meant to be called during initialization, before any sync runs.
+func RegisterDestinationResolver(provider string, r DestinationResolver) {
+	destinationResolvers[NormalizeProvider(provider)] = r
+}
+
+// DestinationProviders returns the names of all providers with a registered
+// DestinationResolver, sorted.
+func DestinationProviders() []string {
+	names := make([]string, 0, len(destinationResolvers))
+	for name := range destinationResolvers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ProviderSkillsDir returns the skills directory of provider (aliases such as
+// "cc" are accepted) under a scope's base directory.
+func ProviderSkillsDir(base, provider, scope string) (string, error) {
+	r, ok := destinationResolvers[NormalizeProvider(provider)]
+	if !ok {
+		return "", fmt.Errorf("unsupported provider: %s", provider)
+	}
+	dir, err := r.SkillsDir(base, scope)
+	if err != nil {
+		return "", fmt.Errorf("provider '%s': %w", NormalizeProvider(provider), err)
+	}
+	return dir, nil
+}
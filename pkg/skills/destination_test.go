@@ -0,0 +1,46 @@
+package skills
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestProviderSkillsDir(t *testing.T) {
+	for _, tc := range []struct {
+		provider, scope, want string
+	}{
+		{"claude", "project", filepath.Join("base", ".claude", "skills")},
+		{"cc", "user", filepath.Join("base", ".claude", "skills")},
+		{"codex", "project", filepath.Join("base", ".codex", "skills")},
+		{"codex", ScopeAdmin, filepath.Join("base", "codex", "skills")},
+		{"opencode", "ecosystem", filepath.Join("base", ".opencode", "skill")},
+	} {
+		got, err := ProviderSkillsDir("base", tc.provider, tc.scope)
+		if err != nil || got != tc.want {
+			t.Errorf("ProviderSkillsDir(%s, %s) = %q, %v; want %q", tc.provider, tc.scope, got, err, tc.want)
+		}
+	}
+
+	if _, err := ProviderSkillsDir("base", "claude", ScopeAdmin); err == nil {
+		t.Error("expected admin scope to be rejected for claude")
+	}
+	if _, err := ProviderSkillsDir("base", "nope", "project"); err == nil {
+		t.Error("expected an unknown provider to be rejected")
+	}
+	if got := GetSkillsDirectoryForWorktree("wt", "nope"); got != filepath.Join("wt", ".claude", "skills") {
+		t.Errorf("unknown provider should fall back to claude layout, got %q", got)
+	}
+}
+
+func TestRegisterDestinationResolver(t *testing.T) {
+	t.Cleanup(func() { delete(destinationResolvers, "gemini") })
+	RegisterDestinationResolver("Gemini", dotDirLayout{Dir: ".gemini", Sub: "skills"})
+
+	if !slices.Contains(DestinationProviders(), "gemini") {
+		t.Fatalf("gemini not registered: %v", DestinationProviders())
+	}
+	if got := GetSkillsDirectoryForWorktree("wt", "gemini"); got != filepath.Join("wt", ".gemini", "skills") {
+		t.Errorf("GetSkillsDirectoryForWorktree = %q", got)
+	}
+}
@@ -0,0 +1,293 @@
+package skills
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// diffContextLines is the number of unchanged lines shown around each change.
+const diffContextLines = 3
+
+// SkillDiff is the pending change to one installed skill: a unified diff from
+// the currently installed files to the files sync would write.
+type SkillDiff struct {
+	Skill string
+	Path  string
+	Diff  string
+}
+
+// diffConfiguredSkills returns a diff for every resolved skill whose installed
+// copy under gitRoot differs from what sync would write. Worktrees under
+// .grove-worktrees/ receive the same content and are not diffed separately.
+func diffConfiguredSkills(gitRoot string, resolved map[string]ResolvedSkill, only map[string]bool, opts SyncOptions) []SkillDiff {
+	names := make([]string, 0, len(resolved))
+	for name := range resolved {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var diffs []SkillDiff
+	for _, name := range names {
+		if only != nil && !only[name] {
+			continue
+		}
+		r := resolved[name]
+		for _, provider := range r.Providers {
+			destPath := filepath.Join(GetSkillsDirectoryForWorktree(gitRoot, provider), name)
+			diff, err := diffResolvedSkill(r, destPath, opts)
+			if err != nil || diff == "" {
+				continue
+			}
+			diffs = append(diffs, SkillDiff{Skill: name, Path: destPath, Diff: diff})
+		}
+	}
+	return diffs
+}
+
+// diffResolvedSkill returns a unified diff of every file that would change if r
+// were installed at destPath, or "" if the installed copy is already current.
+// A missing destination diffs against empty files. Extra installed files are
+// shown as deletions unless opts.Merge is set.
+func diffResolvedSkill(r ResolvedSkill, destPath string, opts SyncOptions) (string, error) {
+	src, err := readSkillSourceFiles(SkillSource{Path: r.PhysicalPath, RelPath: r.RelPath, Type: r.SourceType})
+	if err != nil {
+		return "", err
+	}
+	if _, err := transformSkillFiles(src, opts); err != nil {
+		return "", err
+	}
+
+	dest, err := readSkillFromDisk(destPath)
+	if err != nil {
+		dest = map[string][]byte{}
+	}
+
+	paths := make(map[string]bool, len(src)+len(dest))
+	for p := range src {
+		paths[p] = true
+	}
+	if !opts.Merge {
+		for p := range dest {
+			paths[p] = true
+		}
+	}
+
+	var sb strings.Builder
+	for _, rel := range sortedKeys(paths) {
+		before, inDest := dest[rel]
+		after, inSrc := src[rel]
+		if inDest && inSrc && contentEqual(before, after, opts.Exact) {
+			continue
+		}
+		name := path.Join(r.Name, filepath.ToSlash(rel))
+		oldLabel, newLabel := "a/"+name, "b/"+name
+		if !inDest {
+			oldLabel = "/dev/null"
+		}
+		if !inSrc {
+			newLabel = "/dev/null"
+		}
+		sb.WriteString(unifiedDiff(oldLabel, newLabel, before, after))
+	}
+	return sb.String(), nil
+}
+
+// SkillComparison is the difference between the files of two skills.
+type SkillComparison struct {
+	// OnlyLeft and OnlyRight list slash-separated file paths present in just
+	// one of the skills; Changed lists paths present in both with different
+	// content.
+	OnlyLeft  []string
+	OnlyRight []string
+	Changed   []string
+	// Diff is a unified diff turning the left skill's files into the right's.
+	Diff string
+}
+
+// Empty reports whether the two skills have identical files.
+func (c *SkillComparison) Empty() bool {
+	return len(c.OnlyLeft) == 0 && len(c.OnlyRight) == 0 && len(c.Changed) == 0
+}
+
+// CompareSkills compares the files of two loaded skills, typically different
+// skills such as a builtin and a customized copy under another name. Paths are
+// matched relative to each skill directory and compared byte for byte.
+func CompareSkills(left, right *LoadedSkill) *SkillComparison {
+	cmp := &SkillComparison{}
+	var sb strings.Builder
+	for _, rel := range changedFiles(left.Files, right.Files) {
+		p := filepath.FromSlash(rel)
+		before, inLeft := left.Files[p]
+		after, inRight := right.Files[p]
+		oldLabel, newLabel := path.Join("a", left.Name, rel), path.Join("b", right.Name, rel)
+		switch {
+		case !inRight:
+			cmp.OnlyLeft = append(cmp.OnlyLeft, rel)
+			newLabel = "/dev/null"
+		case !inLeft:
+			cmp.OnlyRight = append(cmp.OnlyRight, rel)
+			oldLabel = "/dev/null"
+		default:
+			cmp.Changed = append(cmp.Changed, rel)
+		}
+		sb.WriteString(unifiedDiff(oldLabel, newLabel, before, after))
+	}
+	cmp.Diff = sb.String()
+	return cmp
+}
+
+// contentEqual compares installed and source file content. Unless exact is
+// set, line endings and trailing whitespace are normalized first, so cosmetic
+// differences (CRLF checkouts, a missing final newline) don't count as changes.
+// Normalization only affects the comparison, never the written content.
+func contentEqual(a, b []byte, exact bool) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+	return !exact && bytes.Equal(normalizeContent(a), normalizeContent(b))
+}
+
+// normalizeContent converts CRLF to LF, trims trailing spaces and tabs from
+// every line and drops trailing blank lines.
+func normalizeContent(content []byte) []byte {
+	lines := bytes.Split(bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n")), []byte("\n"))
+	for i, line := range lines {
+		lines[i] = bytes.TrimRight(line, " \t\r")
+	}
+	return bytes.TrimRight(bytes.Join(lines, []byte("\n")), "\n")
+}
+
+// sortedKeys returns the keys of a string set in sorted order.
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// diffOp is a single line of an edit script: ' ' (keep), '-' (delete) or '+' (insert).
+type diffOp struct {
+	kind byte
+	text string
+}
+
+// unifiedDiff renders a line-based unified diff between a and b with
+// diffContextLines of context. Identical input yields "".
+func unifiedDiff(oldLabel, newLabel string, a, b []byte) string {
+	ops := diffLines(splitLines(a), splitLines(b))
+
+	var changes []int
+	for i, op := range ops {
+		if op.kind != ' ' {
+			changes = append(changes, i)
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", oldLabel, newLabel)
+
+	for i := 0; i < len(changes); {
+		// Extend the hunk while the next change is close enough that the
+		// context windows would overlap.
+		j := i
+		for j+1 < len(changes) && changes[j+1]-changes[j] <= 2*diffContextLines {
+			j++
+		}
+		start := max(changes[i]-diffContextLines, 0)
+		end := min(changes[j]+diffContextLines+1, len(ops))
+
+		oldStart, newStart := 1, 1
+		for _, op := range ops[:start] {
+			if op.kind != '+' {
+				oldStart++
+			}
+			if op.kind != '-' {
+				newStart++
+			}
+		}
+		oldCount, newCount := 0, 0
+		for _, op := range ops[start:end] {
+			if op.kind != '+' {
+				oldCount++
+			}
+			if op.kind != '-' {
+				newCount++
+			}
+		}
+		// An empty range is addressed by the line before it.
+		if oldCount == 0 {
+			oldStart--
+		}
+		if newCount == 0 {
+			newStart--
+		}
+
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n", oldStart, oldCount, newStart, newCount)
+		for _, op := range ops[start:end] {
+			sb.WriteByte(op.kind)
+			sb.WriteString(op.text)
+			sb.WriteByte('\n')
+		}
+		i = j + 1
+	}
+	return sb.String()
+}
+
+// diffLines computes a minimal edit script turning a into b using the longest
+// common subsequence. Skill files are small, so the quadratic table is fine.
+func diffLines(a, b []string) []diffOp {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffOp{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffOp{'+', b[j]})
+	}
+	return ops
+}
+
+// splitLines splits content into lines without their trailing newlines.
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+}
@@ -0,0 +1,149 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	a := []byte("one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\n")
+	b := []byte("one\ntwo\nthree\nfour\nFIVE\nsix\nseven\neight\nnine\nten\neleven\n")
+
+	want := `--- a/f
++++ b/f
+@@ -2,9 +2,10 @@
+ two
+ three
+ four
+-five
++FIVE
+ six
+ seven
+ eight
+ nine
+ ten
++eleven
+`
+	if got := unifiedDiff("a/f", "b/f", a, b); got != want {
+		t.Errorf("unifiedDiff() =\n%s\nwant:\n%s", got, want)
+	}
+
+	if got := unifiedDiff("a/f", "b/f", a, a); got != "" {
+		t.Errorf("expected no diff for identical input, got:\n%s", got)
+	}
+
+	wantNew := "--- /dev/null\n+++ b/f\n@@ -0,0 +1,1 @@\n+hello\n"
+	if got := unifiedDiff("/dev/null", "b/f", nil, []byte("hello\n")); got != wantNew {
+		t.Errorf("new file diff =\n%s\nwant:\n%s", got, wantNew)
+	}
+}
+
+func TestContentEqual(t *testing.T) {
+	a := []byte("one\ntwo\n")
+	for _, b := range []string{"one\r\ntwo\r\n", "one  \ntwo\t\n", "one\ntwo", "one\ntwo\n\n"} {
+		if !contentEqual(a, []byte(b), false) {
+			t.Errorf("expected %q to equal %q after normalization", b, a)
+		}
+		if contentEqual(a, []byte(b), true) {
+			t.Errorf("expected %q to differ from %q with exact comparison", b, a)
+		}
+	}
+	if contentEqual(a, []byte("one\n two\n"), false) {
+		t.Error("leading whitespace changes must still count")
+	}
+}
+
+func TestCompareSkills(t *testing.T) {
+	left := &LoadedSkill{Name: "review", Files: map[string][]byte{
+		"SKILL.md":                          []byte("intro\nstep one\n"),
+		filepath.Join("references", "a.md"): []byte("a\n"),
+	}}
+	right := &LoadedSkill{Name: "my-review", Files: map[string][]byte{
+		"SKILL.md": []byte("intro\nstep two\n"),
+		"extra.md": []byte("x\n"),
+	}}
+
+	cmp := CompareSkills(left, right)
+	if strings.Join(cmp.Changed, ",") != "SKILL.md" || strings.Join(cmp.OnlyLeft, ",") != "references/a.md" || strings.Join(cmp.OnlyRight, ",") != "extra.md" {
+		t.Fatalf("unexpected file sets: %+v", cmp)
+	}
+	for _, want := range []string{"--- a/review/SKILL.md\n+++ b/my-review/SKILL.md\n", "-step one\n+step two\n", "--- /dev/null\n+++ b/my-review/extra.md\n", "--- a/review/references/a.md\n+++ /dev/null\n"} {
+		if !strings.Contains(cmp.Diff, want) {
+			t.Errorf("diff missing %q:\n%s", want, cmp.Diff)
+		}
+	}
+
+	if same := CompareSkills(left, left); !same.Empty() || same.Diff != "" {
+		t.Errorf("expected identical skills to compare empty, got %+v", same)
+	}
+}
+
+func TestDiffResolvedSkill(t *testing.T) {
+	root := t.TempDir()
+	src := writeUserSkill(t, t.TempDir(), "diff-skill", "")
+	r := ResolvedSkill{Name: "diff-skill", SourceType: SourceTypeUser, PhysicalPath: src, Providers: []string{"claude"}}
+	destPath := filepath.Join(root, ".claude", "skills", "diff-skill")
+
+	diff, err := diffResolvedSkill(r, destPath, SyncOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(diff, "--- /dev/null\n+++ b/diff-skill/SKILL.md") {
+		t.Errorf("expected new-file diff for uninstalled skill, got:\n%s", diff)
+	}
+
+	if err := installResolvedSkill(r, destPath, SyncOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if diff, _ := diffResolvedSkill(r, destPath, SyncOptions{}); diff != "" {
+		t.Errorf("expected no diff after install, got:\n%s", diff)
+	}
+
+	installed := filepath.Join(destPath, "SKILL.md")
+	content, err := os.ReadFile(installed) //nolint:gosec // G304: test
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(installed, []byte(strings.ReplaceAll(string(content), "\n", "\r\n")), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+	if diff, _ := diffResolvedSkill(r, destPath, SyncOptions{}); diff != "" {
+		t.Errorf("expected CRLF-only change to be ignored, got:\n%s", diff)
+	}
+	if diff, _ := diffResolvedSkill(r, destPath, SyncOptions{Exact: true}); diff == "" {
+		t.Error("expected CRLF-only change to show with Exact")
+	}
+
+	if err := os.WriteFile(filepath.Join(destPath, "extra.md"), []byte("mine\n"), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+	diff, _ = diffResolvedSkill(r, destPath, SyncOptions{})
+	if !strings.Contains(diff, "--- a/diff-skill/extra.md\n+++ /dev/null") {
+		t.Errorf("expected extra file to show as deleted, got:\n%s", diff)
+	}
+	if diff, _ := diffResolvedSkill(r, destPath, SyncOptions{Merge: true}); diff != "" {
+		t.Errorf("expected extra file to be kept with Merge, got:\n%s", diff)
+	}
+}
+
+func TestPlanPrunes(t *testing.T) {
+	root := t.TempDir()
+	skillsDir := filepath.Join(root, ".claude", "skills")
+	for _, name := range []string{"kept", "stale"} {
+		if err := os.MkdirAll(filepath.Join(skillsDir, name), 0o755); err != nil { //nolint:gosec // G301: test
+			t.Fatal(err)
+		}
+	}
+	resolved := map[string]ResolvedSkill{"kept": {Name: "kept", Providers: []string{"claude"}}}
+
+	got := planPrunes(root, resolved)
+	want := filepath.Join(skillsDir, "stale")
+	if len(got) != 1 || got[0] != want {
+		t.Fatalf("planPrunes() = %v, want [%s]", got, want)
+	}
+	if _, err := os.Stat(want); err != nil {
+		t.Errorf("planPrunes must not remove anything: %v", err)
+	}
+}
@@ -109,7 +109,7 @@ func listSkillsForWorkspace(svc *service.Service, ws *workspace.WorkspaceNode, s
 		return nil, nil
 	}
 
-	skillsDir, err := svc.NotebookLocator.GetSkillsDir(ws)
+	skillsDir, err := svc.NotebookLocator.GetSkillsDir(withNotebook(svc, ws))
 	if err != nil || skillsDir == "" {
 		return nil, nil
 	}
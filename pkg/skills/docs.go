@@ -0,0 +1,81 @@
+package skills
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// SkillDocFileName returns the file name of a skill's page in generated docs.
+func SkillDocFileName(name string) string {
+	return name + ".md"
+}
+
+// RenderSkillDoc renders a markdown documentation page for a skill: a heading,
+// a metadata table built from its frontmatter, and the SKILL.md body.
+func RenderSkillDoc(skill *LoadedSkill) ([]byte, error) {
+	content, ok := skill.Files["SKILL.md"]
+	if !ok {
+		return nil, fmt.Errorf("skill '%s' has no SKILL.md", skill.Name)
+	}
+	meta, rawBody, err := ParseSkill(content)
+	if err != nil {
+		return nil, fmt.Errorf("skill '%s': %w", skill.Name, err)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# %s\n\n", skill.Name)
+	if meta.Description != "" {
+		fmt.Fprintf(&buf, "%s\n\n", meta.Description)
+	}
+
+	buf.WriteString("| Field | Value |\n|---|---|\n")
+	fmt.Fprintf(&buf, "| Source | %s |\n", skill.SourceType)
+	fmt.Fprintf(&buf, "| Version | `%s` |\n", skillDigest(skill.Files)[:12])
+	if meta.Domain != "" {
+		fmt.Fprintf(&buf, "| Domain | %s |\n", meta.Domain)
+	}
+	if len(meta.Aliases) > 0 {
+		fmt.Fprintf(&buf, "| Aliases | %s |\n", strings.Join(meta.Aliases, ", "))
+	}
+	if len(meta.Requires) > 0 {
+		fmt.Fprintf(&buf, "| Requires | %s |\n", docLinks(meta.Requires))
+	}
+	if len(meta.SkillSequence) > 0 {
+		fmt.Fprintf(&buf, "| Sequence | %s |\n", docLinks(meta.SkillSequence))
+	}
+	if meta.Deprecated != "" {
+		fmt.Fprintf(&buf, "| Deprecated | %s |\n", meta.Deprecated)
+	}
+
+	if body := strings.TrimSpace(rawBody); body != "" {
+		buf.WriteString("\n")
+		buf.WriteString(body)
+		buf.WriteString("\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// RenderDocsIndex renders the index page linking every documented skill, in
+// the order given.
+func RenderDocsIndex(skills []*LoadedSkill) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("# Skills\n\n| Skill | Source | Description |\n|---|---|---|\n")
+	for _, skill := range skills {
+		desc := ""
+		if meta, err := ParseSkillFrontmatter(skill.Files["SKILL.md"]); err == nil {
+			desc = strings.ReplaceAll(meta.Description, "|", "\\|")
+		}
+		fmt.Fprintf(&buf, "| [%s](%s) | %s | %s |\n", skill.Name, SkillDocFileName(skill.Name), skill.SourceType, desc)
+	}
+	return buf.Bytes()
+}
+
+// docLinks renders skill names as links to their doc pages.
+func docLinks(names []string) string {
+	links := make([]string, len(names))
+	for i, name := range names {
+		links[i] = fmt.Sprintf("[%s](%s)", name, SkillDocFileName(name))
+	}
+	return strings.Join(links, ", ")
+}
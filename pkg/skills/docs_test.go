@@ -0,0 +1,60 @@
+package skills
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderSkillDoc(t *testing.T) {
+	skill := &LoadedSkill{
+		Name:       "doc-skill",
+		SourceType: SourceTypeUser,
+		Files: map[string][]byte{
+			"SKILL.md": []byte("---\nname: doc-skill\ndescription: Documents things\nrequires: [helper]\n---\n\n# Usage\n\nDo it.\n"),
+		},
+	}
+
+	page, err := RenderSkillDoc(skill)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"# doc-skill\n\nDocuments things\n",
+		"| Source | user |",
+		"| Requires | [helper](helper.md) |",
+		"# Usage\n\nDo it.\n",
+	} {
+		if !strings.Contains(string(page), want) {
+			t.Errorf("page missing %q:\n%s", want, page)
+		}
+	}
+	if strings.Contains(string(page), "name: doc-skill") {
+		t.Error("page should not include raw frontmatter")
+	}
+
+	index := string(RenderDocsIndex([]*LoadedSkill{skill}))
+	if !strings.Contains(index, "| [doc-skill](doc-skill.md) | user | Documents things |") {
+		t.Errorf("unexpected index:\n%s", index)
+	}
+}
+
+func TestRenderSkillDoc_TOMLFrontmatter(t *testing.T) {
+	skill := &LoadedSkill{
+		Name:       "toml-skill",
+		SourceType: SourceTypeProject,
+		Files: map[string][]byte{
+			"SKILL.md": []byte("+++\nname = \"toml-skill\"\ndescription = \"Configured in TOML\"\n+++\n\n# Usage\n\nDo it.\n"),
+		},
+	}
+
+	page, err := RenderSkillDoc(skill)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(page), "Configured in TOML\n") || !strings.Contains(string(page), "# Usage\n\nDo it.\n") {
+		t.Errorf("unexpected page:\n%s", page)
+	}
+	if strings.Contains(string(page), "+++") || strings.Contains(string(page), "name = ") {
+		t.Errorf("page should not include raw frontmatter:\n%s", page)
+	}
+}
@@ -0,0 +1,133 @@
+package skills
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+
+	"github.com/grovetools/core/git"
+	"github.com/grovetools/core/pkg/workspace"
+	"github.com/grovetools/skills/pkg/service"
+)
+
+// Drift severities, ordered from least to most severe.
+const (
+	DriftNone = iota
+	// DriftChanged means installed skills are modified, renamed or
+	// undeclared ones are present, but every configured skill is installed.
+	DriftChanged
+	// DriftMissing means at least one configured skill is not installed.
+	DriftMissing
+)
+
+// DriftReport summarizes how the installed skills of a workspace (and its
+// worktrees) differ from what sync would produce. Each list holds installed
+// skill directories. With SyncOptions.DetectRenames, installs matched to a
+// renamed skill are listed in Renamed instead of as missing and extra.
+type DriftReport struct {
+	Workspace string        `json:"workspace"`
+	Missing   []string      `json:"missing"`
+	Modified  []string      `json:"modified"`
+	Extra     []string      `json:"extra"`
+	Renamed   []SkillRename `json:"renamed,omitempty"`
+}
+
+// Severity returns DriftNone, DriftChanged or DriftMissing.
+func (d *DriftReport) Severity() int {
+	switch {
+	case len(d.Missing) > 0:
+		return DriftMissing
+	case len(d.Modified) > 0 || len(d.Extra) > 0 || len(d.Renamed) > 0:
+		return DriftChanged
+	default:
+		return DriftNone
+	}
+}
+
+// ReportDrift compares the workspace's installed skills against their resolved
+// sources without modifying anything. opts selects the same content
+// transforms and discovery filters as the sync being compared against.
+func ReportDrift(svc *service.Service, node *workspace.WorkspaceNode, opts SyncOptions) (*DriftReport, error) {
+	if node == nil {
+		return nil, fmt.Errorf("workspace node is required")
+	}
+	gitRoot, err := git.GetGitRoot(node.Path)
+	if err != nil {
+		gitRoot = node.Path
+	}
+
+	skillsCfg, err := loadDesiredSkillsConfig(svc.Config, node)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load skills config: %w", err)
+	}
+	if skillsCfg == nil {
+		skillsCfg = &SkillsConfig{}
+	}
+
+	resolved, err := ResolveConfiguredSkillsWithOptions(svc, node, skillsCfg, DiscoveryOptions{
+		IncludeDisabled: opts.IncludeDisabled,
+		ExcludeSources:  opts.ExcludeSources,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve skills: %w", err)
+	}
+
+	providers := []string{"claude"}
+	if len(skillsCfg.Providers) > 0 {
+		providers = skillsCfg.Providers
+	}
+	report := computeDrift(gitRoot, resolved, providers, opts)
+	report.Workspace = node.Name
+	return report, nil
+}
+
+// computeDrift compares resolved skills against their installed copies under
+// gitRoot and its worktrees. Every provider directory is checked for extras,
+// including configured providers with no resolved skills.
+func computeDrift(gitRoot string, resolved map[string]ResolvedSkill, providers []string, opts SyncOptions) *DriftReport {
+	report := &DriftReport{Missing: []string{}, Modified: []string{}, Extra: []string{}}
+
+	keep := make(map[string]map[string]bool)
+	for _, provider := range providers {
+		keep[provider] = nil
+	}
+	for name, r := range resolved {
+		for _, provider := range r.Providers {
+			if keep[provider] == nil {
+				keep[provider] = make(map[string]bool)
+			}
+			keep[provider][name] = true
+		}
+	}
+
+	for _, root := range syncRoots(gitRoot) {
+		for name, r := range resolved {
+			for _, provider := range r.Providers {
+				destPath := filepath.Join(GetSkillsDirectoryForWorktree(root, provider), name)
+				if _, err := os.Stat(filepath.Join(destPath, "SKILL.md")); err != nil {
+					report.Missing = append(report.Missing, destPath)
+				} else if !skillUpToDate(r, destPath, opts) {
+					report.Modified = append(report.Modified, destPath)
+				}
+			}
+		}
+		for provider, names := range keep {
+			report.Extra = append(report.Extra, pruneCandidates(GetSkillsDirectoryForWorktree(root, provider), names)...)
+		}
+	}
+
+	if opts.DetectRenames {
+		report.Renamed = detectRenames(gitRoot, resolved, opts)
+		for _, r := range report.Renamed {
+			report.Missing = slices.DeleteFunc(report.Missing, func(p string) bool { return p == r.NewPath() })
+			report.Extra = slices.DeleteFunc(report.Extra, func(p string) bool { return p == r.Path })
+		}
+	}
+
+	sort.Strings(report.Missing)
+	sort.Strings(report.Modified)
+	sort.Strings(report.Extra)
+	return report
+}
@@ -0,0 +1,50 @@
+package skills
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestComputeDrift(t *testing.T) {
+	root := t.TempDir()
+	srcDir := t.TempDir()
+	resolved := map[string]ResolvedSkill{}
+	for _, name := range []string{"kept-skill", "edited-skill", "absent-skill"} {
+		src := writeUserSkill(t, srcDir, name, "")
+		resolved[name] = ResolvedSkill{Name: name, SourceType: SourceTypeUser, PhysicalPath: src, Providers: []string{"claude"}}
+	}
+	if _, _, errs := syncConfiguredSkills(context.Background(), root, resolved, nil, SyncOptions{}, nil); len(errs) > 0 {
+		t.Fatalf("sync: %v", errs)
+	}
+
+	if report := computeDrift(root, resolved, []string{"claude"}, SyncOptions{}); report.Severity() != DriftNone {
+		t.Fatalf("expected no drift after sync, got %+v", report)
+	}
+
+	skillsDir := filepath.Join(root, ".claude", "skills")
+	if err := os.WriteFile(filepath.Join(skillsDir, "edited-skill", "SKILL.md"), []byte("---\nname: edited-skill\ndescription: changed\n---\n"), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+	if err := os.RemoveAll(filepath.Join(skillsDir, "absent-skill")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(skillsDir, "stray-skill"), 0o755); err != nil { //nolint:gosec // G301: test
+		t.Fatal(err)
+	}
+
+	report := computeDrift(root, resolved, []string{"claude"}, SyncOptions{})
+	if len(report.Missing) != 1 || filepath.Base(report.Missing[0]) != "absent-skill" {
+		t.Errorf("Missing = %v", report.Missing)
+	}
+	if len(report.Modified) != 1 || filepath.Base(report.Modified[0]) != "edited-skill" {
+		t.Errorf("Modified = %v", report.Modified)
+	}
+	if len(report.Extra) != 1 || filepath.Base(report.Extra[0]) != "stray-skill" {
+		t.Errorf("Extra = %v", report.Extra)
+	}
+	if report.Severity() != DriftMissing {
+		t.Errorf("Severity = %d, want %d", report.Severity(), DriftMissing)
+	}
+}
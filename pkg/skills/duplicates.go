@@ -0,0 +1,74 @@
+package skills
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/grovetools/core/pkg/workspace"
+	"github.com/grovetools/skills/pkg/service"
+)
+
+// DuplicateSkill is a skill name defined more than once within a single
+// discovery tier, e.g. by two notebooks. Winner is the copy discovery uses
+// (the shallowest, then the first in scan order); Others are the copies it
+// ignores.
+type DuplicateSkill struct {
+	Name   string
+	Tier   string
+	Winner string
+	Others []string
+}
+
+// duplicateTiers are the tiers whose roots are merged with
+// addSkillSourceSafely, where same-tier collisions are resolved silently.
+var duplicateTiers = map[string]SourceType{
+	"user":      SourceTypeUser,
+	"notebook":  SourceTypeEcosystem,
+	"ecosystem": SourceTypeEcosystem,
+	"project":   SourceTypeProject,
+}
+
+// FindDuplicateSkills reports every skill name that more than one directory
+// within the same tier defines for node, sorted by tier order then name.
+func FindDuplicateSkills(svc *service.Service, node *workspace.WorkspaceNode) []DuplicateSkill {
+	byTier := make(map[string][]string)
+	for _, root := range SkillSourceRoots(svc, node) {
+		if _, ok := duplicateTiers[root.Tier]; ok {
+			byTier[root.Tier] = append(byTier[root.Tier], root.Dir)
+		}
+	}
+
+	var dups []DuplicateSkill
+	for _, tier := range SourceTiers {
+		dirs := byTier[tier]
+		if len(dirs) == 0 {
+			continue
+		}
+		winners := make(map[string]SkillSource)
+		paths := make(map[string][]string)
+		for _, dir := range dirs {
+			walkSkillTree(svc, dir, func(skillPath, relDir string) {
+				name := filepath.Base(skillPath)
+				paths[name] = append(paths[name], skillPath)
+				addSkillSourceSafely(winners, name, SkillSource{Path: skillPath, RelPath: relDir, Type: duplicateTiers[tier]})
+			})
+		}
+		names := make([]string, 0, len(paths))
+		for name, p := range paths {
+			if len(p) > 1 {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			dup := DuplicateSkill{Name: name, Tier: tier, Winner: winners[name].Path}
+			for _, p := range paths[name] {
+				if p != dup.Winner {
+					dup.Others = append(dup.Others, p)
+				}
+			}
+			dups = append(dups, dup)
+		}
+	}
+	return dups
+}
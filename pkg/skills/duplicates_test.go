@@ -0,0 +1,50 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grovetools/core/config"
+	"github.com/grovetools/skills/pkg/service"
+)
+
+func TestFindDuplicateSkills_Notebooks(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	root := t.TempDir()
+	writeNotebookSkill := func(notebook, ws string) string {
+		dir := filepath.Join(root, notebook, "workspaces", ws, "skills", "dup-skill")
+		if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gosec // G301: test
+			t.Fatal(err)
+		}
+		content := "---\nname: dup-skill\ndescription: Test skill\n---\n"
+		if err := os.WriteFile(filepath.Join(dir, "SKILL.md"), []byte(content), 0o644); err != nil { //nolint:gosec // G306: test
+			t.Fatal(err)
+		}
+		return dir
+	}
+	a := writeNotebookSkill("alpha", "w1")
+	b := writeNotebookSkill("beta", "w2")
+
+	svc := &service.Service{Config: &config.Config{Notebooks: &config.NotebooksConfig{Definitions: map[string]*config.Notebook{
+		"beta":  {RootDir: filepath.Join(root, "beta")},
+		"alpha": {RootDir: filepath.Join(root, "alpha")},
+	}}}}
+
+	dups := FindDuplicateSkills(svc, nil)
+	if len(dups) != 1 {
+		t.Fatalf("expected one duplicate, got %+v", dups)
+	}
+	if dups[0].Name != "dup-skill" || dups[0].Tier != "notebook" || dups[0].Winner != a || len(dups[0].Others) != 1 || dups[0].Others[0] != b {
+		t.Errorf("unexpected duplicate: %+v", dups[0])
+	}
+
+	// Discovery must agree with the reported winner on every run.
+	for range 5 {
+		sources := make(map[string]SkillSource)
+		addNotebookSkillSources(svc, sources)
+		if sources["dup-skill"].Path != a {
+			t.Fatalf("expected notebook alpha to win, got %s", sources["dup-skill"].Path)
+		}
+	}
+}
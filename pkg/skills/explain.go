@@ -0,0 +1,112 @@
+package skills
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grovetools/core/pkg/workspace"
+	"github.com/grovetools/skills/pkg/service"
+)
+
+// ResolutionStep is one discovery tier checked while resolving a skill.
+type ResolutionStep struct {
+	Tier  string `json:"tier"`
+	Found bool   `json:"found"`
+	Path  string `json:"path,omitempty"`
+	// Note qualifies the step, e.g. a tier skipped by --embedded-only or a
+	// copy marked disabled.
+	Note string `json:"note,omitempty"`
+}
+
+// ResolutionTrace explains how a skill name resolves: every tier checked in
+// precedence order (lowest first), the tier that won and why.
+type ResolutionTrace struct {
+	Query string `json:"query"`
+	// Name is the canonical skill name Query resolved to, which differs when
+	// Query is an alias or workspace-qualified.
+	Name   string           `json:"name"`
+	Steps  []ResolutionStep `json:"steps"`
+	Winner string           `json:"winner,omitempty"`
+	Reason string           `json:"reason"`
+}
+
+// ExplainSkillResolution traces the resolution of skillName the way
+// LoadSkillBypassingAccessWithService performs it. Each tier is discovered on
+// its own so copies hidden by precedence show up. A skill that doesn't resolve
+// is not an error: the trace has no Winner and Reason says why.
+func ExplainSkillResolution(svc *service.Service, node *workspace.WorkspaceNode, skillName string) (*ResolutionTrace, error) {
+	trace := &ResolutionTrace{Query: skillName, Steps: []ResolutionStep{}}
+	wsName, unqualified := ResolveQualifiedSkillName(skillName)
+	trace.Name = unqualified
+
+	if wsName != "" {
+		skill, err := FindSkillAcrossWorkspaces(svc, skillName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search workspaces: %w", err)
+		}
+		step := ResolutionStep{Tier: "workspace:" + wsName, Found: skill != nil}
+		if skill != nil {
+			step.Path = skill.Path
+			trace.Winner = step.Tier
+			trace.Reason = fmt.Sprintf("workspace-qualified names skip the precedence chain and resolve only in workspace '%s'", wsName)
+		} else {
+			trace.Reason = fmt.Sprintf("workspace '%s' does not define '%s'", wsName, unqualified)
+		}
+		trace.Steps = append(trace.Steps, step)
+		return trace, nil
+	}
+
+	canonical, _, resolved := lookupSkillSource(ListSkillSources(svc, node), unqualified)
+	if resolved && canonical != unqualified {
+		trace.Name = canonical
+	}
+
+	var found []string
+	topDisabled := false
+	for _, tier := range SourceTiers {
+		step := ResolutionStep{Tier: tier}
+		if (DiscoveryOptions{}).excludes(svc, tier) {
+			step.Note = "skipped (--embedded-only)"
+			trace.Steps = append(trace.Steps, step)
+			continue
+		}
+		var exclude []string
+		for _, other := range SourceTiers {
+			if other != tier {
+				exclude = append(exclude, other)
+			}
+		}
+		sources := ListSkillSourcesWithOptions(svc, node, DiscoveryOptions{IncludeDisabled: true, ExcludeSources: exclude})
+		if src, ok := sources[trace.Name]; ok {
+			step.Found = true
+			step.Path = src.Path
+			meta, err := ReadSkillMetadata(src)
+			topDisabled = err == nil && meta.Disabled
+			if topDisabled {
+				step.Note = "disabled"
+			}
+			found = append(found, tier)
+		}
+		trace.Steps = append(trace.Steps, step)
+	}
+
+	switch {
+	case resolved && len(found) > 0:
+		trace.Winner = found[len(found)-1]
+		if len(found) == 1 {
+			trace.Reason = fmt.Sprintf("only the %s tier defines it", trace.Winner)
+		} else {
+			trace.Reason = fmt.Sprintf("%s has the highest precedence of the tiers defining it (%s)", trace.Winner, strings.Join(found, ", "))
+		}
+		if trace.Name != unqualified {
+			trace.Reason = fmt.Sprintf("'%s' is an alias of '%s'; %s", unqualified, trace.Name, trace.Reason)
+		}
+	case topDisabled:
+		trace.Reason = fmt.Sprintf("the highest-precedence copy, in %s, is disabled, which hides the skill", found[len(found)-1])
+	case nodeSkillScope(node) != nil && !nodeSkillScope(node).Allows(trace.Name):
+		trace.Reason = "the workspace's .skills-scope does not allow it"
+	default:
+		trace.Reason = "no tier defines it"
+	}
+	return trace, nil
+}
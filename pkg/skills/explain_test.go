@@ -0,0 +1,32 @@
+package skills
+
+import "testing"
+
+func TestExplainSkillResolution(t *testing.T) {
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+	writeUserSkill(t, configHome, "explain-with-analogy", "")
+
+	trace, err := ExplainSkillResolution(nil, nil, "explain-with-analogy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trace.Winner != "user" {
+		t.Errorf("Winner = %q, want user (reason: %s)", trace.Winner, trace.Reason)
+	}
+	found := map[string]bool{}
+	for _, step := range trace.Steps {
+		found[step.Tier] = step.Found
+	}
+	if !found["builtin"] || !found["user"] || found["project"] {
+		t.Errorf("steps = %+v", trace.Steps)
+	}
+
+	missing, err := ExplainSkillResolution(nil, nil, "no-such-skill")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if missing.Winner != "" || missing.Reason != "no tier defines it" {
+		t.Errorf("missing skill trace = %+v", missing)
+	}
+}
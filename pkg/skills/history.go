@@ -0,0 +1,142 @@
+package skills
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"time"
+)
+
+// Actions recorded in the skills history log.
+const (
+	HistoryActionSync   = "sync"
+	HistoryActionPrune  = "prune"
+	HistoryActionRemove = "remove"
+)
+
+// HistoryEntry is one line of the skills history log.
+type HistoryEntry struct {
+	Time     time.Time `json:"time"`
+	Action   string    `json:"action"`
+	Skill    string    `json:"skill"`
+	Scope    string    `json:"scope,omitempty"`
+	Provider string    `json:"provider,omitempty"`
+	Source   string    `json:"source,omitempty"`
+	Path     string    `json:"path,omitempty"`
+}
+
+// HistoryPath returns the location of the history log
+// (~/.config/grove/skills-history.log, honoring XDG_CONFIG_HOME).
+func HistoryPath() string {
+	skillsDir := getUserSkillsPath()
+	if skillsDir == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(skillsDir), "skills-history.log")
+}
+
+// AppendHistory appends entries to the history log as JSON lines. Entries
+// without a timestamp are stamped with the current time.
+func AppendHistory(entries ...HistoryEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	path := HistoryPath()
+	if path == "" {
+		return fmt.Errorf("could not determine history log location")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil { //nolint:gosec // G301: config dir
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644) //nolint:gosec // G302: user-readable log
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	now := time.Now().UTC()
+	enc := json.NewEncoder(f)
+	for _, e := range entries {
+		if e.Time.IsZero() {
+			e.Time = now
+		}
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadHistory returns all entries in the history log, oldest first. A missing
+// log yields no entries. Malformed lines are skipped.
+func ReadHistory() ([]HistoryEntry, error) {
+	path := HistoryPath()
+	if path == "" {
+		return nil, nil
+	}
+	f, err := os.Open(path) //nolint:gosec // G304: fixed config path
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	var entries []HistoryEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e HistoryEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	return entries, scanner.Err()
+}
+
+// recordSyncHistory appends one "sync" entry per provider of each written skill
+// and one "prune" entry per removed directory. Skills that were already up to
+// date, or failed, are not recorded. Logging is best effort and never fails
+// the sync.
+func recordSyncHistory(workspaceName, gitRoot string, resolved map[string]ResolvedSkill, written, pruned []string, errs []SyncError) {
+	failed := make(map[string]bool, len(errs))
+	for _, e := range errs {
+		failed[e.Skill] = true
+	}
+
+	names := slices.Clone(written)
+	sort.Strings(names)
+
+	var entries []HistoryEntry
+	for _, name := range names {
+		r, ok := resolved[name]
+		if !ok || failed[name] {
+			continue
+		}
+		for _, provider := range r.Providers {
+			entries = append(entries, HistoryEntry{
+				Action:   HistoryActionSync,
+				Skill:    name,
+				Scope:    workspaceName,
+				Provider: NormalizeProvider(provider),
+				Source:   string(r.SourceType),
+				Path:     filepath.Join(GetSkillsDirectoryForWorktree(gitRoot, provider), name),
+			})
+		}
+	}
+	for _, path := range pruned {
+		entries = append(entries, HistoryEntry{
+			Action: HistoryActionPrune,
+			Skill:  filepath.Base(path),
+			Scope:  workspaceName,
+			Path:   path,
+		})
+	}
+	_ = AppendHistory(entries...)
+}
@@ -0,0 +1,62 @@
+package skills
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHistory_AppendAndRead(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	entries, err := ReadHistory()
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("expected empty history, got %v (err=%v)", entries, err)
+	}
+
+	stamp := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	if err := AppendHistory(
+		HistoryEntry{Time: stamp, Action: HistoryActionSync, Skill: "alpha", Provider: "claude"},
+		HistoryEntry{Action: HistoryActionRemove, Skill: "beta", Scope: "user"},
+	); err != nil {
+		t.Fatalf("AppendHistory: %v", err)
+	}
+
+	entries, err = ReadHistory()
+	if err != nil {
+		t.Fatalf("ReadHistory: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if !entries[0].Time.Equal(stamp) || entries[0].Skill != "alpha" {
+		t.Errorf("unexpected first entry: %+v", entries[0])
+	}
+	if entries[1].Time.IsZero() || entries[1].Action != HistoryActionRemove {
+		t.Errorf("expected stamped remove entry, got %+v", entries[1])
+	}
+}
+
+func TestRecordSyncHistory_OnlyWrittenSkills(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	resolved := map[string]ResolvedSkill{
+		"alpha": {Name: "alpha", SourceType: SourceTypeUser, Providers: []string{"claude"}},
+		"beta":  {Name: "beta", SourceType: SourceTypeUser, Providers: []string{"claude"}},
+		"gamma": {Name: "gamma", SourceType: SourceTypeUser, Providers: []string{"claude"}},
+	}
+
+	// A no-op sync writes nothing and must not touch history.
+	recordSyncHistory("ws", "/repo", resolved, nil, nil, nil)
+	if entries, err := ReadHistory(); err != nil || len(entries) != 0 {
+		t.Fatalf("expected no entries after a no-op sync, got %v (err=%v)", entries, err)
+	}
+
+	recordSyncHistory("ws", "/repo", resolved, []string{"beta", "alpha"}, nil, []SyncError{{Skill: "beta"}})
+	entries, err := ReadHistory()
+	if err != nil {
+		t.Fatalf("ReadHistory: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Skill != "alpha" || entries[0].Action != HistoryActionSync {
+		t.Errorf("expected a single sync entry for alpha, got %+v", entries)
+	}
+}
@@ -0,0 +1,67 @@
+package skills
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// SampleSkillName is the skill seeded by InitUserSkillsDir when asked.
+const SampleSkillName = "my-first-skill"
+
+const sampleSkillContent = `---
+name: my-first-skill
+description: Example user skill created by 'grove-skills init'. Replace this with when an agent should use the skill.
+---
+
+# My First Skill
+
+Describe the workflow the agent should follow, step by step.
+
+1. Rename this directory and the 'name' field above (they must match).
+2. Add the skill to [skills] use in grove.toml and run 'grove-skills sync'.
+`
+
+// InitResult reports what InitUserSkillsDir created. Fields are false when
+// the directory or sample already existed.
+type InitResult struct {
+	Path          string
+	Created       bool
+	SamplePath    string
+	SampleCreated bool
+}
+
+// InitUserSkillsDir creates the user skills directory (honoring
+// XDG_CONFIG_HOME) and, with sample set, seeds SampleSkillName in it. Existing
+// directories and files are left untouched, so it is safe to run repeatedly.
+func InitUserSkillsDir(sample bool) (*InitResult, error) {
+	path := getUserSkillsPath()
+	if path == "" {
+		return nil, fmt.Errorf("could not determine the user config directory")
+	}
+	result := &InitResult{Path: path}
+
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		result.Created = true
+	}
+	if err := os.MkdirAll(path, 0o755); err != nil { //nolint:gosec // G301: user skills dir
+		return nil, fmt.Errorf("failed to create %s: %w", path, err)
+	}
+
+	if !sample {
+		return result, nil
+	}
+	result.SamplePath = filepath.Join(path, SampleSkillName)
+	skillFile := filepath.Join(result.SamplePath, "SKILL.md")
+	if _, err := os.Stat(skillFile); err == nil {
+		return result, nil
+	}
+	if err := os.MkdirAll(result.SamplePath, 0o755); err != nil { //nolint:gosec // G301: skill dir
+		return nil, err
+	}
+	if err := os.WriteFile(skillFile, []byte(sampleSkillContent), 0o644); err != nil { //nolint:gosec // G306: skill files
+		return nil, err
+	}
+	result.SampleCreated = true
+	return result, nil
+}
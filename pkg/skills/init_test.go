@@ -0,0 +1,47 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestInitUserSkillsDir(t *testing.T) {
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+
+	result, err := InitUserSkillsDir(true)
+	if err != nil {
+		t.Fatalf("InitUserSkillsDir: %v", err)
+	}
+	if want := filepath.Join(configHome, "grove", "skills"); result.Path != want {
+		t.Errorf("Path = %s, want %s", result.Path, want)
+	}
+	if !result.Created || !result.SampleCreated {
+		t.Errorf("expected directory and sample to be created, got %+v", result)
+	}
+
+	content, err := os.ReadFile(filepath.Join(result.SamplePath, "SKILL.md")) //nolint:gosec // G304: test
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateSkillContent(content, SampleSkillName); err != nil {
+		t.Errorf("sample skill does not validate: %v", err)
+	}
+
+	// A second run must not report or overwrite anything.
+	if err := os.WriteFile(filepath.Join(result.SamplePath, "SKILL.md"), []byte("edited"), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+	again, err := InitUserSkillsDir(true)
+	if err != nil {
+		t.Fatalf("second InitUserSkillsDir: %v", err)
+	}
+	if again.Created || again.SampleCreated {
+		t.Errorf("expected idempotent second run, got %+v", again)
+	}
+	content, _ = os.ReadFile(filepath.Join(result.SamplePath, "SKILL.md")) //nolint:gosec // G304: test
+	if string(content) != "edited" {
+		t.Error("second run overwrote the sample skill")
+	}
+}
@@ -0,0 +1,121 @@
+package skills
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// ListInstalled returns the names of skills installed under basePath (e.g. a
+// provider directory such as .claude/skills). A subdirectory counts as an
+// installed skill only if it contains a SKILL.md. A missing basePath yields
+// an empty result rather than an error.
+func ListInstalled(basePath string) ([]string, error) {
+	entries, err := os.ReadDir(basePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(basePath, entry.Name(), "SKILL.md")); err != nil {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// InstalledComparison describes how two installed skill sets differ, e.g. the
+// claude and codex directories of the same scope.
+type InstalledComparison struct {
+	// OnlyLeft and OnlyRight list skills installed on one side only.
+	OnlyLeft  []string `json:"onlyLeft"`
+	OnlyRight []string `json:"onlyRight"`
+	// Changed lists skills installed on both sides whose files differ.
+	Changed []ChangedSkill `json:"changed"`
+}
+
+// ChangedSkill names an installed skill and the files (relative to the skill
+// directory) that differ or exist on only one side.
+type ChangedSkill struct {
+	Skill string   `json:"skill"`
+	Files []string `json:"files"`
+}
+
+// Empty reports whether both sides are identical.
+func (c *InstalledComparison) Empty() bool {
+	return len(c.OnlyLeft) == 0 && len(c.OnlyRight) == 0 && len(c.Changed) == 0
+}
+
+// CompareInstalled compares the skills installed under two base paths by name
+// and content.
+func CompareInstalled(leftPath, rightPath string) (*InstalledComparison, error) {
+	left, err := ListInstalled(leftPath)
+	if err != nil {
+		return nil, err
+	}
+	right, err := ListInstalled(rightPath)
+	if err != nil {
+		return nil, err
+	}
+
+	rightSet := make(map[string]bool, len(right))
+	for _, name := range right {
+		rightSet[name] = true
+	}
+
+	cmp := &InstalledComparison{OnlyLeft: []string{}, OnlyRight: []string{}, Changed: []ChangedSkill{}}
+	for _, name := range left {
+		if !rightSet[name] {
+			cmp.OnlyLeft = append(cmp.OnlyLeft, name)
+			continue
+		}
+		delete(rightSet, name)
+
+		leftFiles, err := readSkillFromDisk(filepath.Join(leftPath, name))
+		if err != nil {
+			return nil, err
+		}
+		rightFiles, err := readSkillFromDisk(filepath.Join(rightPath, name))
+		if err != nil {
+			return nil, err
+		}
+		if files := changedFiles(leftFiles, rightFiles); len(files) > 0 {
+			cmp.Changed = append(cmp.Changed, ChangedSkill{Skill: name, Files: files})
+		}
+	}
+	cmp.OnlyRight = append(cmp.OnlyRight, sortedKeys(rightSet)...)
+	return cmp, nil
+}
+
+// changedFiles returns the sorted slash-separated paths whose content differs
+// between a and b, including files present in only one of them.
+func changedFiles(a, b map[string][]byte) []string {
+	paths := make(map[string]bool, len(a)+len(b))
+	for p := range a {
+		paths[p] = true
+	}
+	for p := range b {
+		paths[p] = true
+	}
+
+	var changed []string
+	for _, p := range sortedKeys(paths) {
+		before, inA := a[p]
+		after, inB := b[p]
+		if inA && inB && bytes.Equal(before, after) {
+			continue
+		}
+		changed = append(changed, filepath.ToSlash(p))
+	}
+	return changed
+}
@@ -0,0 +1,93 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestListInstalled_MissingDir(t *testing.T) {
+	names, err := ListInstalled(filepath.Join(t.TempDir(), "does-not-exist"))
+	if err != nil {
+		t.Fatalf("expected no error for missing dir, got: %v", err)
+	}
+	if len(names) != 0 {
+		t.Errorf("expected no skills, got %v", names)
+	}
+}
+
+func TestListInstalled_EmptyDir(t *testing.T) {
+	names, err := ListInstalled(t.TempDir())
+	if err != nil {
+		t.Fatalf("ListInstalled: %v", err)
+	}
+	if len(names) != 0 {
+		t.Errorf("expected no skills, got %v", names)
+	}
+}
+
+func TestListInstalled_FiltersNonSkillEntries(t *testing.T) {
+	base := t.TempDir()
+
+	for _, name := range []string{"zeta", "alpha"} {
+		dir := filepath.Join(base, name)
+		if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gosec // G301: test
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "SKILL.md"), []byte("---\nname: "+name+"\n---\n"), 0o644); err != nil { //nolint:gosec // G306: test
+			t.Fatal(err)
+		}
+	}
+
+	// A directory without SKILL.md is not an installed skill
+	if err := os.MkdirAll(filepath.Join(base, "not-a-skill"), 0o755); err != nil { //nolint:gosec // G301: test
+		t.Fatal(err)
+	}
+	// Loose files at the top level are ignored
+	if err := os.WriteFile(filepath.Join(base, "README.md"), []byte("notes"), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+
+	names, err := ListInstalled(base)
+	if err != nil {
+		t.Fatalf("ListInstalled: %v", err)
+	}
+	want := []string{"alpha", "zeta"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("got %v, want %v", names, want)
+	}
+}
+
+func TestCompareInstalled(t *testing.T) {
+	left, right := t.TempDir(), t.TempDir()
+	write := func(base, name, body string) {
+		t.Helper()
+		dir := filepath.Join(base, name)
+		if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gosec // G301: test
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "SKILL.md"), []byte("---\nname: "+name+"\n---\n"+body), 0o644); err != nil { //nolint:gosec // G306: test
+			t.Fatal(err)
+		}
+	}
+	write(left, "shared", "same")
+	write(right, "shared", "same")
+	write(left, "edited", "old")
+	write(right, "edited", "new")
+	write(left, "claude-only", "")
+	write(right, "codex-only", "")
+
+	cmp, err := CompareInstalled(left, right)
+	if err != nil {
+		t.Fatalf("CompareInstalled: %v", err)
+	}
+	want := &InstalledComparison{
+		OnlyLeft:  []string{"claude-only"},
+		OnlyRight: []string{"codex-only"},
+		Changed:   []ChangedSkill{{Skill: "edited", Files: []string{"SKILL.md"}}},
+	}
+	if !reflect.DeepEqual(cmp, want) {
+		t.Errorf("got %+v, want %+v", cmp, want)
+	}
+}
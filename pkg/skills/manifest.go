@@ -0,0 +1,84 @@
+package skills
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	coreconfig "github.com/grovetools/core/config"
+	"github.com/grovetools/core/pkg/workspace"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// SkillsManifestEnv names an environment variable pointing at a skills
+// manifest. When set, sync installs exactly the skills the manifest declares
+// instead of those configured in grove.toml, so container images can pin their
+// skill set without a committed config file.
+const SkillsManifestEnv = "GROVE_SKILLS_MANIFEST"
+
+// skillsManifest is the on-disk shape of a skills manifest: the parts of a
+// [skills] block that describe what to install.
+type skillsManifest struct {
+	Use          []string                    `toml:"use" yaml:"use"`
+	Providers    []string                    `toml:"providers" yaml:"providers"`
+	Dependencies map[string]DependencyConfig `toml:"dependencies" yaml:"dependencies"`
+}
+
+// LoadSkillsManifest reads the manifest at path. Files ending in .yml or .yaml
+// are YAML; anything else is TOML. Unknown fields, invalid names in use (which
+// may be workspace-qualified) and a manifest declaring no skills are errors.
+// Skills that don't exist are reported when sync resolves them.
+func LoadSkillsManifest(path string) (*SkillsConfig, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: user-supplied manifest path
+	if err != nil {
+		return nil, fmt.Errorf("failed to read skills manifest: %w", err)
+	}
+
+	var m skillsManifest
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yml", ".yaml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err = dec.Decode(&m); err == io.EOF {
+			err = nil
+		}
+	default:
+		err = toml.NewDecoder(bytes.NewReader(data)).DisallowUnknownFields().Decode(&m)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid skills manifest %s: %w", path, err)
+	}
+
+	if len(m.Use) == 0 && len(m.Dependencies) == 0 {
+		return nil, fmt.Errorf("skills manifest %s declares no skills", path)
+	}
+	var invalid []string
+	for _, name := range m.Use {
+		if _, unqualified := ResolveQualifiedSkillName(name); !nameRegex.MatchString(unqualified) {
+			invalid = append(invalid, name)
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("skills manifest %s has invalid skill names: %v", path, invalid)
+	}
+
+	return applySkillsDefaults(&SkillsConfig{
+		Use:          m.Use,
+		Providers:    m.Providers,
+		Dependencies: m.Dependencies,
+	}), nil
+}
+
+// loadDesiredSkillsConfig returns the skills config sync should apply: the
+// manifest named by $GROVE_SKILLS_MANIFEST when set, otherwise the workspace's
+// merged grove.toml configuration.
+func loadDesiredSkillsConfig(cfg *coreconfig.Config, node *workspace.WorkspaceNode) (*SkillsConfig, error) {
+	if path := os.Getenv(SkillsManifestEnv); path != "" {
+		return LoadSkillsManifest(path)
+	}
+	return LoadSkillsConfig(cfg, node)
+}
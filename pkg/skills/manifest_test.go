@@ -0,0 +1,63 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadSkillsManifest(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	cfg, err := LoadSkillsManifest(write("skills.toml", "use = [\"explain-with-analogy\", \"eco:review\"]\nproviders = [\"codex\"]\n"))
+	if err != nil {
+		t.Fatalf("toml manifest: %v", err)
+	}
+	if len(cfg.Use) != 2 || cfg.Providers[0] != "codex" {
+		t.Errorf("toml manifest = %+v", cfg)
+	}
+
+	cfg, err = LoadSkillsManifest(write("skills.yml", "use:\n  - explain-with-analogy\n"))
+	if err != nil {
+		t.Fatalf("yaml manifest: %v", err)
+	}
+	if len(cfg.Use) != 1 || len(cfg.Providers) != 1 || cfg.Providers[0] != "claude" {
+		t.Errorf("yaml manifest = %+v, want default provider", cfg)
+	}
+
+	for name, tc := range map[string]struct{ file, content, want string }{
+		"unknown field": {"a.toml", "use = [\"x\"]\nskills = []\n", "invalid skills manifest"},
+		"invalid name":  {"b.yaml", "use: [Bad_Name]\n", "invalid skill names"},
+		"empty":         {"c.toml", "providers = [\"claude\"]\n", "declares no skills"},
+	} {
+		_, err := LoadSkillsManifest(write(tc.file, tc.content))
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: err = %v, want %q", name, err, tc.want)
+		}
+	}
+}
+
+func TestLoadDesiredSkillsConfig_Env(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "skills.toml")
+	if err := os.WriteFile(path, []byte("use = [\"only-this\"]\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(SkillsManifestEnv, path)
+
+	cfg, err := loadDesiredSkillsConfig(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Use) != 1 || cfg.Use[0] != "only-this" {
+		t.Errorf("Use = %v, want [only-this]", cfg.Use)
+	}
+}
@@ -0,0 +1,41 @@
+package skills
+
+import (
+	"runtime"
+	"sync"
+)
+
+// DefaultJobs is the worker count used by whole-library operations when no
+// --jobs value is given.
+func DefaultJobs() int {
+	return runtime.GOMAXPROCS(0)
+}
+
+// MapBounded calls fn for every item using at most jobs concurrent workers
+// and returns the results in input order, so output stays deterministic no
+// matter how the work is scheduled. A jobs value below 1 means DefaultJobs.
+func MapBounded[T, R any](items []T, jobs int, fn func(T) R) []R {
+	if jobs < 1 {
+		jobs = DefaultJobs()
+	}
+	jobs = min(jobs, len(items))
+
+	results := make([]R, len(items))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = fn(items[i])
+			}
+		}()
+	}
+	for i := range items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return results
+}
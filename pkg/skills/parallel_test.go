@@ -0,0 +1,38 @@
+package skills
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+func TestMapBounded(t *testing.T) {
+	items := make([]int, 50)
+	for i := range items {
+		items[i] = i
+	}
+
+	var running, peak atomic.Int32
+	got := MapBounded(items, 4, func(n int) int {
+		cur := running.Add(1)
+		for {
+			p := peak.Load()
+			if cur <= p || peak.CompareAndSwap(p, cur) {
+				break
+			}
+		}
+		defer running.Add(-1)
+		return n * n
+	})
+
+	for i, v := range got {
+		if v != i*i {
+			t.Fatalf("result %d = %d, want %d", i, v, i*i)
+		}
+	}
+	if peak.Load() > 4 {
+		t.Errorf("ran %d workers at once, want at most 4", peak.Load())
+	}
+	if got := MapBounded([]int(nil), 0, func(n int) int { return n }); len(got) != 0 {
+		t.Errorf("expected no results for empty input, got %v", got)
+	}
+}
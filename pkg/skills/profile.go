@@ -0,0 +1,90 @@
+package skills
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// SourceProfile is a named source selection defined under [skills.profiles]:
+//
+//	[skills.profiles.minimal]
+//	sources = ["builtin", "user"]
+//
+// Sources, when set, keeps only the matching tiers; ExcludeSources then
+// removes tiers from what remains. Entries may be globs such as "note*".
+type SourceProfile struct {
+	Sources        []string `toml:"sources" yaml:"sources"`
+	ExcludeSources []string `toml:"exclude_sources" yaml:"exclude_sources"`
+}
+
+// ExpandSourcePatterns expands tier names and globs (e.g. "*", "eco*") into
+// the matching entries of SourceTiers, in precedence order. A pattern that
+// matches no tier is an error.
+func ExpandSourcePatterns(patterns []string) ([]string, error) {
+	matched := make(map[string]bool)
+	for _, pattern := range patterns {
+		found := false
+		for _, tier := range SourceTiers {
+			if ok, err := path.Match(pattern, tier); err != nil {
+				return nil, fmt.Errorf("invalid source pattern %q: %w", pattern, err)
+			} else if ok {
+				matched[tier] = true
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("source %q matches no tier (valid: %s)", pattern, strings.Join(SourceTiers, ", "))
+		}
+	}
+
+	var tiers []string
+	for _, tier := range SourceTiers {
+		if matched[tier] {
+			tiers = append(tiers, tier)
+		}
+	}
+	return tiers, nil
+}
+
+// ProfileExcludes returns the tiers to exclude for the named profile, suitable
+// for DiscoveryOptions.ExcludeSources.
+func (c *SkillsConfig) ProfileExcludes(name string) ([]string, error) {
+	var profile SourceProfile
+	var ok bool
+	if c != nil {
+		profile, ok = c.Profiles[name]
+	}
+	if !ok {
+		var names []string
+		if c != nil {
+			for n := range c.Profiles {
+				names = append(names, n)
+			}
+		}
+		sort.Strings(names)
+		if len(names) == 0 {
+			return nil, fmt.Errorf("unknown profile %q: no [skills.profiles] are configured", name)
+		}
+		return nil, fmt.Errorf("unknown profile %q (available: %s)", name, strings.Join(names, ", "))
+	}
+
+	excluded, err := ExpandSourcePatterns(profile.ExcludeSources)
+	if err != nil {
+		return nil, fmt.Errorf("profile %q: %w", name, err)
+	}
+	if len(profile.Sources) > 0 {
+		kept, err := ExpandSourcePatterns(profile.Sources)
+		if err != nil {
+			return nil, fmt.Errorf("profile %q: %w", name, err)
+		}
+		for _, tier := range SourceTiers {
+			if !slices.Contains(kept, tier) && !slices.Contains(excluded, tier) {
+				excluded = append(excluded, tier)
+			}
+		}
+	}
+	return excluded, nil
+}
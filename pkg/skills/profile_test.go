@@ -0,0 +1,50 @@
+package skills
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+func TestSkillsConfig_ProfileExcludes(t *testing.T) {
+	var parsed groveTomlSkills
+	data := `
+[skills.profiles.minimal]
+sources = ["builtin", "user"]
+
+[skills.profiles.no-notes]
+exclude_sources = ["note*", "eco*"]
+`
+	if err := toml.Unmarshal([]byte(data), &parsed); err != nil {
+		t.Fatal(err)
+	}
+	cfg := parsed.Skills
+
+	got, err := cfg.ProfileExcludes("minimal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"notebook", "ecosystem", "project", "playbook"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("minimal excludes = %v, want %v", got, want)
+	}
+
+	got, err = cfg.ProfileExcludes("no-notes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"notebook", "ecosystem"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("no-notes excludes = %v, want %v", got, want)
+	}
+
+	if _, err := cfg.ProfileExcludes("missing"); err == nil || !strings.Contains(err.Error(), "minimal, no-notes") {
+		t.Errorf("expected unknown profile error listing profiles, got %v", err)
+	}
+}
+
+func TestExpandSourcePatterns_RejectsUnknown(t *testing.T) {
+	if _, err := ExpandSourcePatterns([]string{"remote"}); err == nil {
+		t.Error("expected error for a pattern matching no tier")
+	}
+}
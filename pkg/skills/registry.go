@@ -27,6 +27,20 @@ func (e *ErrSkillNotAuthorized) Error() string {
 	return fmt.Sprintf("skill '%s' is not authorized in workspace '%s' (add to grove.toml [skills] use)", e.SkillName, e.WorkDir)
 }
 
+// SkillNotFoundError is returned when a skill name resolves in no source.
+type SkillNotFoundError struct {
+	SkillName string
+	// Workspace is set for workspace-qualified names ("workspace:skill").
+	Workspace string
+}
+
+func (e *SkillNotFoundError) Error() string {
+	if e.Workspace != "" {
+		return fmt.Sprintf("skill '%s' not found in workspace '%s'", e.SkillName, e.Workspace)
+	}
+	return fmt.Sprintf("skill '%s' not found", e.SkillName)
+}
+
 // LoadAuthorizedSkill resolves a skill and ensures the workspace has explicitly declared it
 // in grove.toml (via [skills] use or [skills.dependencies]).
 func LoadAuthorizedSkill(workDir, skillName string) (*LoadedSkill, error) {
@@ -155,17 +169,7 @@ func checkSkillSequenceContains(svc *service.Service, node *workspace.WorkspaceN
 	}
 	visited[skillName] = true
 
-	loaded, err := loadSkillInternal(svc, node, skillName)
-	if err != nil {
-		return false
-	}
-
-	content, ok := loaded.Files["SKILL.md"]
-	if !ok {
-		return false
-	}
-
-	meta, err := ParseSkillFrontmatter(content)
+	meta, _, err := GetSkillMetadata(svc, node, skillName)
 	if err != nil {
 		return false
 	}
@@ -183,29 +187,47 @@ func checkSkillSequenceContains(svc *service.Service, node *workspace.WorkspaceN
 
 // loadSkillInternal handles the actual resolution and file loading.
 func loadSkillInternal(svc *service.Service, node *workspace.WorkspaceNode, skillName string) (*LoadedSkill, error) {
-	wsName, unqualifiedName := ResolveQualifiedSkillName(skillName)
+	name, src, err := resolveSkillSource(svc, node, skillName)
+	if err != nil {
+		return nil, err
+	}
+	return LoadSkillFromSource(name, src)
+}
 
-	var src SkillSource
-	var found bool
+// GetSkillMetadata resolves a skill through the normal precedence chain and
+// parses only its SKILL.md frontmatter, without reading the rest of the skill
+// directory. Use it instead of loading the skill when only metadata is needed.
+func GetSkillMetadata(svc *service.Service, node *workspace.WorkspaceNode, skillName string) (*SkillMetadata, SourceType, error) {
+	_, src, err := resolveSkillSource(svc, node, skillName)
+	if err != nil {
+		return nil, "", err
+	}
+	meta, err := ReadSkillMetadata(src)
+	if err != nil {
+		return nil, src.Type, fmt.Errorf("failed to read skill metadata: %w", err)
+	}
+	return meta, src.Type, nil
+}
+
+// resolveSkillSource finds the winning source for a (possibly qualified or
+// aliased) skill name and returns its canonical unqualified name.
+func resolveSkillSource(svc *service.Service, node *workspace.WorkspaceNode, skillName string) (string, SkillSource, error) {
+	wsName, unqualifiedName := ResolveQualifiedSkillName(skillName)
 
 	if wsName != "" {
 		skill, err := FindSkillAcrossWorkspaces(svc, skillName)
 		if err != nil {
-			return nil, fmt.Errorf("failed to search workspaces: %w", err)
+			return "", SkillSource{}, fmt.Errorf("failed to search workspaces: %w", err)
 		}
 		if skill == nil {
-			return nil, fmt.Errorf("skill '%s' not found in workspace '%s'", unqualifiedName, wsName)
+			return "", SkillSource{}, &SkillNotFoundError{SkillName: unqualifiedName, Workspace: wsName}
 		}
-		src = SkillSource{Path: skill.Path, RelPath: skill.RelPath, Type: SourceTypeEcosystem}
-		found = true
-	} else {
-		sources := ListSkillSources(svc, node)
-		src, found = sources[unqualifiedName]
+		return unqualifiedName, SkillSource{Path: skill.Path, RelPath: skill.RelPath, Type: SourceTypeEcosystem}, nil
 	}
 
-	if !found {
-		return nil, fmt.Errorf("skill '%s' not found", unqualifiedName)
+	sources := ListSkillSources(svc, node)
+	if canonical, src, ok := lookupSkillSource(sources, unqualifiedName); ok {
+		return canonical, src, nil
 	}
-
-	return LoadSkillFromSource(unqualifiedName, src)
+	return "", SkillSource{}, &SkillNotFoundError{SkillName: unqualifiedName}
 }
@@ -148,3 +148,24 @@ func TestLoadSkillBypassingAccess_IgnoresAuthorization(t *testing.T) {
 		t.Errorf("expected name 'explain-with-analogy', got '%s'", loaded.Name)
 	}
 }
+
+func TestGetSkillMetadata_Builtin(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	meta, sourceType, err := GetSkillMetadata(nil, nil, "explain-with-analogy")
+	if err != nil {
+		t.Fatalf("GetSkillMetadata: %v", err)
+	}
+	if meta.Name != "explain-with-analogy" || meta.Description == "" {
+		t.Errorf("unexpected metadata: %+v", meta)
+	}
+	if sourceType != SourceTypeBuiltin {
+		t.Errorf("expected builtin source, got %s", sourceType)
+	}
+
+	_, _, err = GetSkillMetadata(nil, nil, "no-such-skill")
+	var notFound *SkillNotFoundError
+	if !errors.As(err, &notFound) || notFound.SkillName != "no-such-skill" {
+		t.Errorf("expected SkillNotFoundError for unknown skill, got %v", err)
+	}
+}
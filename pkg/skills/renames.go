@@ -0,0 +1,136 @@
+package skills
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/grovetools/core/logging"
+)
+
+// SkillRename is an installed skill whose source directory was renamed: the
+// directory at Path holds skill From, whose content matches the configured
+// skill To that isn't installed yet.
+type SkillRename struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Path string `json:"path"`
+}
+
+// NewPath returns where the renamed skill belongs.
+func (r SkillRename) NewPath() string {
+	return filepath.Join(filepath.Dir(r.Path), r.To)
+}
+
+// detectRenames matches installed skills that are no longer configured to
+// configured skills that aren't installed, in every provider directory of
+// gitRoot and its worktrees. Two skills match when their SKILL.md content
+// fingerprints are equal (see skillFingerprint); a fingerprint shared by more
+// than one candidate is ambiguous and never matched.
+func detectRenames(gitRoot string, resolved map[string]ResolvedSkill, opts SyncOptions) []SkillRename {
+	keep := make(map[string]map[string]bool)
+	for name, r := range resolved {
+		for _, provider := range r.Providers {
+			if keep[provider] == nil {
+				keep[provider] = make(map[string]bool)
+			}
+			keep[provider][name] = true
+		}
+	}
+
+	sourcePrints := make(map[string]string)
+	sourcePrint := func(name string) string {
+		if fp, ok := sourcePrints[name]; ok {
+			return fp
+		}
+		r := resolved[name]
+		files, err := readSkillSourceFiles(SkillSource{Path: r.PhysicalPath, RelPath: r.RelPath, Type: r.SourceType})
+		fp := ""
+		if err == nil {
+			if _, err := transformSkillFiles(files, opts); err == nil {
+				fp = skillFingerprint(files["SKILL.md"])
+			}
+		}
+		sourcePrints[name] = fp
+		return fp
+	}
+
+	var renames []SkillRename
+	for _, root := range syncRoots(gitRoot) {
+		for provider, names := range keep {
+			dir := GetSkillsDirectoryForWorktree(root, provider)
+			targets := make(map[string][]string)
+			for name := range names {
+				if _, err := os.Stat(filepath.Join(dir, name, "SKILL.md")); err == nil {
+					continue
+				}
+				if fp := sourcePrint(name); fp != "" {
+					targets[fp] = append(targets[fp], name)
+				}
+			}
+			if len(targets) == 0 {
+				continue
+			}
+
+			orphans := make(map[string][]string)
+			for _, path := range pruneCandidates(dir, names) {
+				content, err := os.ReadFile(filepath.Join(path, "SKILL.md")) //nolint:gosec // G304: installed skill
+				if err != nil {
+					continue
+				}
+				if fp := skillFingerprint(content); fp != "" {
+					orphans[fp] = append(orphans[fp], path)
+				}
+			}
+			for fp, paths := range orphans {
+				if len(paths) != 1 || len(targets[fp]) != 1 {
+					continue
+				}
+				renames = append(renames, SkillRename{From: filepath.Base(paths[0]), To: targets[fp][0], Path: paths[0]})
+			}
+		}
+	}
+
+	sort.Slice(renames, func(i, j int) bool { return renames[i].Path < renames[j].Path })
+	return renames
+}
+
+// skillFingerprint hashes the description and body of a SKILL.md, ignoring
+// the rest of the frontmatter (a renamed skill's name necessarily changes)
+// and cosmetic whitespace. It returns "" for content that can't be parsed or
+// has neither a description nor a body.
+func skillFingerprint(content []byte) string {
+	meta, rawBody, err := ParseSkill(content)
+	if err != nil {
+		return ""
+	}
+	body := normalizeContent([]byte(rawBody))
+	if meta.Description == "" && len(body) == 0 {
+		return ""
+	}
+
+	h := sha256.New()
+	h.Write([]byte(meta.Description))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// migrateRenames moves each renamed install to its new name so the following
+// sync updates it in place instead of installing a duplicate.
+func migrateRenames(renames []SkillRename, logger *logging.PrettyLogger) []SyncError {
+	var errs []SyncError
+	for _, r := range renames {
+		if err := os.Rename(r.Path, r.NewPath()); err != nil {
+			errs = append(errs, SyncError{Skill: r.To, Phase: SyncPhaseWrite, Err: fmt.Errorf("failed to move renamed skill %s: %w", r.Path, err)})
+			continue
+		}
+		if logger != nil {
+			logger.InfoPretty(fmt.Sprintf("Moved renamed skill '%s' to: %s", r.From, r.NewPath()))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,77 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDetectRenames(t *testing.T) {
+	root := t.TempDir()
+	srcDir := t.TempDir()
+	writeSkill := func(dir, name, description string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(path, 0o755); err != nil { //nolint:gosec // G301: test
+			t.Fatal(err)
+		}
+		content := "---\nname: " + name + "\ndescription: " + description + "\n---\n\nBody.\n"
+		if err := os.WriteFile(filepath.Join(path, "SKILL.md"), []byte(content), 0o644); err != nil { //nolint:gosec // G306: test
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	skillsDir := filepath.Join(root, ".claude", "skills")
+	writeSkill(skillsDir, "old-name", "Reviews code")
+	writeSkill(skillsDir, "unrelated", "Something else")
+	resolved := map[string]ResolvedSkill{
+		"new-name": {Name: "new-name", SourceType: SourceTypeUser, PhysicalPath: writeSkill(srcDir, "new-name", "Reviews code"), Providers: []string{"claude"}},
+	}
+
+	renames := detectRenames(root, resolved, SyncOptions{})
+	want := SkillRename{From: "old-name", To: "new-name", Path: filepath.Join(skillsDir, "old-name")}
+	if len(renames) != 1 || renames[0] != want {
+		t.Fatalf("detectRenames = %+v, want [%+v]", renames, want)
+	}
+
+	report := computeDrift(root, resolved, []string{"claude"}, SyncOptions{DetectRenames: true})
+	if len(report.Missing) != 0 || len(report.Renamed) != 1 {
+		t.Errorf("drift Missing = %v, Renamed = %v", report.Missing, report.Renamed)
+	}
+	if len(report.Extra) != 1 || filepath.Base(report.Extra[0]) != "unrelated" {
+		t.Errorf("drift Extra = %v", report.Extra)
+	}
+
+	if errs := migrateRenames(renames, nil); len(errs) > 0 {
+		t.Fatalf("migrateRenames: %v", errs)
+	}
+	if _, err := os.Stat(filepath.Join(skillsDir, "old-name")); !os.IsNotExist(err) {
+		t.Errorf("old install still present: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(skillsDir, "new-name", "SKILL.md")); err != nil {
+		t.Errorf("renamed install missing: %v", err)
+	}
+}
+
+func TestDetectRenames_Ambiguous(t *testing.T) {
+	root := t.TempDir()
+	skillsDir := filepath.Join(root, ".claude", "skills")
+	srcDir := t.TempDir()
+	for _, dir := range []string{filepath.Join(skillsDir, "old-a"), filepath.Join(skillsDir, "old-b"), filepath.Join(srcDir, "new-name")} {
+		if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gosec // G301: test
+			t.Fatal(err)
+		}
+		content := "---\nname: " + filepath.Base(dir) + "\ndescription: Same\n---\n\nBody.\n"
+		if err := os.WriteFile(filepath.Join(dir, "SKILL.md"), []byte(content), 0o644); err != nil { //nolint:gosec // G306: test
+			t.Fatal(err)
+		}
+	}
+	resolved := map[string]ResolvedSkill{
+		"new-name": {Name: "new-name", SourceType: SourceTypeUser, PhysicalPath: filepath.Join(srcDir, "new-name"), Providers: []string{"claude"}},
+	}
+
+	if renames := detectRenames(root, resolved, SyncOptions{}); len(renames) != 0 {
+		t.Errorf("expected no renames for ambiguous match, got %+v", renames)
+	}
+}
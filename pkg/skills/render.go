@@ -0,0 +1,86 @@
+package skills
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+)
+
+// RenderSkillIncludes returns the skill's SKILL.md with every file listed in
+// its `includes` frontmatter appended to the body, in order, separated by a
+// blank line. Include paths are relative to the skill directory.
+func RenderSkillIncludes(files map[string][]byte) ([]byte, error) {
+	content, ok := files["SKILL.md"]
+	if !ok {
+		return nil, fmt.Errorf("missing SKILL.md")
+	}
+	if err := ValidateSkillIncludes(files); err != nil {
+		return nil, err
+	}
+	meta, err := ParseSkillFrontmatter(content)
+	if err != nil {
+		return nil, err
+	}
+	if len(meta.Includes) == 0 {
+		return content, nil
+	}
+
+	var buf bytes.Buffer
+	buf.Write(bytes.TrimRight(content, "\n"))
+	buf.WriteString("\n")
+	for _, inc := range meta.Includes {
+		buf.WriteString("\n")
+		buf.Write(bytes.TrimRight(files[filepath.FromSlash(inc)], "\n"))
+		buf.WriteString("\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// ValidateSkillIncludes checks that every file named in the skill's `includes`
+// frontmatter is part of the skill.
+func ValidateSkillIncludes(files map[string][]byte) error {
+	meta, err := ParseSkillFrontmatter(files["SKILL.md"])
+	if err != nil {
+		return err
+	}
+	var missing []string
+	for _, inc := range meta.Includes {
+		if _, ok := files[filepath.FromSlash(inc)]; !ok {
+			missing = append(missing, inc)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("includes not found in skill '%s': %v", meta.Name, missing)
+	}
+	return nil
+}
+
+// transformSkillFiles applies the SKILL.md content transforms selected in opts
+// (--canonicalize, then --render, then --strip-comments) to files in place. It
+// reports whether any transform was applied.
+func transformSkillFiles(files map[string][]byte, opts SyncOptions) (bool, error) {
+	content, ok := files["SKILL.md"]
+	if !ok || (!opts.Canonicalize && !opts.Render && !opts.StripComments) {
+		return false, nil
+	}
+	if opts.Canonicalize {
+		canonical, err := CanonicalizeSkillContent(content)
+		if err != nil {
+			return false, err
+		}
+		content = canonical
+		files["SKILL.md"] = content
+	}
+	if opts.Render {
+		rendered, err := RenderSkillIncludes(files)
+		if err != nil {
+			return false, err
+		}
+		content = rendered
+	}
+	if opts.StripComments {
+		content = StripSkillContent(content)
+	}
+	files["SKILL.md"] = content
+	return true, nil
+}
@@ -0,0 +1,31 @@
+package skills
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderSkillIncludes(t *testing.T) {
+	files := map[string][]byte{
+		"SKILL.md":      []byte("---\nname: big\ndescription: d\nincludes: [parts/b.md, parts/a.md]\n---\n\nIntro.\n\n"),
+		"parts/a.md":    []byte("Part A.\n"),
+		"parts/b.md":    []byte("Part B.\n"),
+		"parts/skip.md": []byte("Not included.\n"),
+	}
+
+	got, err := RenderSkillIncludes(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(string(got), "Intro.\n\nPart B.\n\nPart A.\n") {
+		t.Errorf("unexpected rendered content:\n%s", got)
+	}
+	if strings.Contains(string(got), "Not included") {
+		t.Error("rendered unlisted part")
+	}
+
+	delete(files, "parts/a.md")
+	if _, err := RenderSkillIncludes(files); err == nil || !strings.Contains(err.Error(), "parts/a.md") {
+		t.Errorf("expected missing include error, got %v", err)
+	}
+}
@@ -0,0 +1,122 @@
+package skills
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/grovetools/core/git"
+	"github.com/grovetools/core/pkg/workspace"
+	"github.com/grovetools/skills/pkg/service"
+)
+
+// RepairResult describes the fix applied to one partially installed skill.
+type RepairResult struct {
+	Skill string
+	Path  string
+	// Reinstalled is set when SKILL.md was missing and the whole skill was
+	// rewritten from source.
+	Reinstalled bool
+	// Restored lists the files re-copied from source, relative to Path.
+	Restored []string
+}
+
+// RepairWorkspace restores missing files in the installed copies of the
+// workspace's configured skills. Only skills whose destination directory
+// exists are considered; skills that were never installed are left for sync.
+// When names is empty every configured skill is checked.
+func RepairWorkspace(svc *service.Service, node *workspace.WorkspaceNode, names []string) ([]RepairResult, error) {
+	if node == nil {
+		return nil, fmt.Errorf("workspace node is required")
+	}
+
+	gitRoot, err := git.GetGitRoot(node.Path)
+	if err != nil {
+		gitRoot = node.Path
+	}
+
+	skillsCfg, err := LoadSkillsConfig(svc.Config, node)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load skills config: %w", err)
+	}
+	if skillsCfg == nil {
+		skillsCfg = &SkillsConfig{}
+	}
+
+	resolved, err := ResolveConfiguredSkills(svc, node, skillsCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve skills: %w", err)
+	}
+
+	if len(names) == 0 {
+		for name := range resolved {
+			names = append(names, name)
+		}
+	} else {
+		for _, name := range names {
+			if _, ok := resolved[name]; !ok {
+				return nil, fmt.Errorf("skill '%s' is not configured in this workspace", name)
+			}
+		}
+	}
+	sort.Strings(names)
+
+	var results []RepairResult
+	for _, root := range syncRoots(gitRoot) {
+		for _, name := range names {
+			r := resolved[name]
+			for _, provider := range r.Providers {
+				destPath := filepath.Join(GetSkillsDirectoryForWorktree(root, provider), name)
+				result, err := repairInstalledSkill(r, destPath)
+				if err != nil {
+					return results, fmt.Errorf("failed to repair %s: %w", destPath, err)
+				}
+				if result != nil {
+					results = append(results, *result)
+				}
+			}
+		}
+	}
+	return results, nil
+}
+
+// repairInstalledSkill re-copies any source files missing from destPath, or
+// reinstalls the skill if its SKILL.md is gone. It returns nil when destPath
+// does not exist or is already complete.
+func repairInstalledSkill(r ResolvedSkill, destPath string) (*RepairResult, error) {
+	if _, err := os.Stat(destPath); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if _, err := os.Stat(filepath.Join(destPath, "SKILL.md")); os.IsNotExist(err) {
+		if err := writeResolvedSkill(r, destPath, SyncOptions{}); err != nil {
+			return nil, err
+		}
+		return &RepairResult{Skill: r.Name, Path: destPath, Reinstalled: true}, nil
+	}
+
+	src, err := readSkillSourceFiles(SkillSource{Path: r.PhysicalPath, RelPath: r.RelPath, Type: r.SourceType})
+	if err != nil {
+		return nil, err
+	}
+
+	var restored []string
+	for _, relPath := range sortedFileKeys(src) {
+		filePath := filepath.Join(destPath, relPath)
+		if _, err := os.Stat(filePath); !os.IsNotExist(err) {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil { //nolint:gosec // G301: skill subdir
+			return nil, err
+		}
+		if err := os.WriteFile(filePath, src[relPath], 0o644); err != nil { //nolint:gosec // G306: skill files
+			return nil, err
+		}
+		restored = append(restored, filepath.ToSlash(relPath))
+	}
+	if len(restored) == 0 {
+		return nil, nil
+	}
+	return &RepairResult{Skill: r.Name, Path: destPath, Restored: restored}, nil
+}
@@ -2,9 +2,6 @@ package skills
 
 import (
 	"fmt"
-	"io/fs"
-	"os"
-	"path/filepath"
 
 	"github.com/grovetools/core/pkg/workspace"
 	"github.com/grovetools/skills/pkg/service"
@@ -65,11 +62,22 @@ func ExpandUseWithPlaybookSkills(node *workspace.WorkspaceNode, use []string) []
 // It also recursively traverses SKILL.md dependencies to implicitly resolve
 // nested sub-skills (via skill_sequence and requires).
 func ResolveConfiguredSkills(svc *service.Service, node *workspace.WorkspaceNode, cfg *SkillsConfig) (map[string]ResolvedSkill, error) {
+	return ResolveConfiguredSkillsWithOptions(svc, node, cfg, DiscoveryOptions{})
+}
+
+// ResolveConfiguredSkillsWithOptions is ResolveConfiguredSkills with explicit
+// discovery options. Declared skills that are marked `disabled: true` are
+// skipped (not treated as missing) unless opts.IncludeDisabled is set.
+func ResolveConfiguredSkillsWithOptions(svc *service.Service, node *workspace.WorkspaceNode, cfg *SkillsConfig, opts DiscoveryOptions) (map[string]ResolvedSkill, error) {
 	if cfg == nil {
 		return nil, nil
 	}
 
-	availableSources := ListSkillSources(svc, node)
+	// Always discover disabled skills so a declared-but-disabled skill is
+	// skipped rather than reported as missing.
+	discoveryOpts := opts
+	discoveryOpts.IncludeDisabled = true
+	availableSources := ListSkillSourcesWithOptions(svc, node, discoveryOpts)
 	defaultProviders := cfg.Providers
 	if len(defaultProviders) == 0 {
 		defaultProviders = []string{"claude"}
@@ -130,7 +138,12 @@ func ResolveConfiguredSkills(svc *service.Service, node *workspace.WorkspaceNode
 			found = true
 			resolveName = unqualifiedName
 		} else {
-			src, found = availableSources[resolveName]
+			// Aliases resolve to their canonical skill, which is then
+			// installed under the canonical name.
+			var canonical string
+			if canonical, src, found = lookupSkillSource(availableSources, resolveName); found {
+				resolveName, unqualifiedName = canonical, canonical
+			}
 		}
 
 		if !found {
@@ -155,6 +168,12 @@ func ResolveConfiguredSkills(svc *service.Service, node *workspace.WorkspaceNode
 			}
 		}
 
+		// Read SKILL.md to recursively resolve implicit dependencies (skill_sequence, requires)
+		meta, metaErr := ReadSkillMetadata(src)
+		if metaErr == nil && meta.Disabled && !opts.IncludeDisabled {
+			return nil
+		}
+
 		resolved[unqualifiedName] = ResolvedSkill{
 			Name:         unqualifiedName,
 			SourceType:   src.Type,
@@ -163,26 +182,15 @@ func ResolveConfiguredSkills(svc *service.Service, node *workspace.WorkspaceNode
 			Providers:    depProviders,
 		}
 
-		// Read SKILL.md to recursively resolve implicit dependencies (skill_sequence, requires)
-		var content []byte
-		var err error
-		if src.Type == SourceTypeBuiltin {
-			content, err = fs.ReadFile(embeddedSkillsFS, filepath.Join("data/skills", src.RelPath, "SKILL.md"))
-		} else {
-			content, err = os.ReadFile(filepath.Join(src.Path, "SKILL.md"))
-		}
-
-		if err == nil {
-			if meta, err := ParseSkillFrontmatter(content); err == nil {
-				for _, req := range meta.Requires {
-					if err := resolveTransitive(req, depProviders, ""); err != nil {
-						return err
-					}
+		if metaErr == nil {
+			for _, req := range meta.Requires {
+				if err := resolveTransitive(req, depProviders, ""); err != nil {
+					return err
 				}
-				for _, seq := range meta.SkillSequence {
-					if err := resolveTransitive(seq, depProviders, ""); err != nil {
-						return err
-					}
+			}
+			for _, seq := range meta.SkillSequence {
+				if err := resolveTransitive(seq, depProviders, ""); err != nil {
+					return err
 				}
 			}
 		}
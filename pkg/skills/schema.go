@@ -0,0 +1,62 @@
+package skills
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// FrontmatterSchema is a compiled JSON Schema used to validate SKILL.md
+// frontmatter beyond the built-in name/description checks. It lets teams
+// enforce organization-specific frontmatter fields.
+type FrontmatterSchema struct {
+	schema *jsonschema.Schema
+}
+
+// LoadFrontmatterSchema compiles the JSON Schema file at path.
+func LoadFrontmatterSchema(path string) (*FrontmatterSchema, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := jsonschema.Compile(abs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile schema %s: %w", path, err)
+	}
+	return &FrontmatterSchema{schema: schema}, nil
+}
+
+// Validate parses the frontmatter of a SKILL.md into a generic map and
+// validates it against the schema.
+func (s *FrontmatterSchema) Validate(content []byte) error {
+	frontmatter, format, err := extractFrontmatter(content)
+	if err != nil {
+		return err
+	}
+
+	var raw map[string]interface{}
+	if err := unmarshalFrontmatter(frontmatter, format, &raw); err != nil {
+		return err
+	}
+	if raw == nil {
+		raw = map[string]interface{}{}
+	}
+
+	// Round-trip through JSON so YAML/TOML scalars take the shapes the schema
+	// validator expects (json.Number, []interface{}, map[string]interface{}).
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return fmt.Errorf("frontmatter cannot be represented as JSON: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return err
+	}
+
+	return s.schema.Validate(doc)
+}
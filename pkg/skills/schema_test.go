@@ -0,0 +1,91 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const teamSchema = `{
+  "type": "object",
+  "required": ["name", "description", "owner"],
+  "properties": {
+    "owner": {"type": "string", "minLength": 1},
+    "version": {"type": "integer"}
+  }
+}`
+
+func TestLoadFrontmatterSchema(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil { //nolint:gosec // G306: test
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{"valid schema", write("valid.json", teamSchema), false},
+		{"invalid json", write("broken.json", "{\"type\": "), true},
+		{"invalid schema", write("bad-type.json", `{"type": 42}`), true},
+		{"missing file", filepath.Join(dir, "missing.json"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, err := LoadFrontmatterSchema(tt.path)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error loading %s", tt.path)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFrontmatterSchema: %v", err)
+			}
+			if schema == nil {
+				t.Fatal("expected a compiled schema")
+			}
+		})
+	}
+}
+
+func TestFrontmatterSchema_Validate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schema.json")
+	if err := os.WriteFile(path, []byte(teamSchema), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+	schema, err := LoadFrontmatterSchema(path)
+	if err != nil {
+		t.Fatalf("LoadFrontmatterSchema: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"valid yaml", "---\nname: demo\ndescription: A demo\nowner: platform\nversion: 2\n---\nBody\n", false},
+		{"valid toml", "+++\nname = \"demo\"\ndescription = \"A demo\"\nowner = \"platform\"\n+++\nBody\n", false},
+		{"missing required field", "---\nname: demo\ndescription: A demo\n---\nBody\n", true},
+		{"wrong field type", "---\nname: demo\ndescription: A demo\nowner: platform\nversion: two\n---\nBody\n", true},
+		{"empty owner", "---\nname: demo\ndescription: A demo\nowner: \"\"\n---\nBody\n", true},
+		{"no frontmatter", "# Just a body\n", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Validate([]byte(tt.content))
+			if tt.wantErr && err == nil {
+				t.Error("expected a validation error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected validation error: %v", err)
+			}
+		})
+	}
+}
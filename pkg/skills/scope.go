@@ -0,0 +1,92 @@
+package skills
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/grovetools/core/pkg/workspace"
+)
+
+// SkillScopeFileName is the per-project file restricting which skills the
+// project may use.
+const SkillScopeFileName = ".skills-scope"
+
+// SkillScope is a project's skill allowlist/denylist, read from a
+// .skills-scope file. Each non-empty line that doesn't start with '#' is a
+// skill name or glob (e.g. "review-*"); a leading '!' makes it a deny rule.
+// When any allow rule is present only matching skills are allowed. Deny rules
+// always win.
+type SkillScope struct {
+	Allow []string
+	Deny  []string
+}
+
+// LoadSkillScope reads dir/.skills-scope. A missing file yields nil, meaning
+// no restriction. Lines with malformed globs are ignored.
+func LoadSkillScope(dir string) (*SkillScope, error) {
+	data, err := os.ReadFile(filepath.Join(dir, SkillScopeFileName)) //nolint:gosec // G304: project config path
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	scope := &SkillScope{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		pattern, deny := strings.CutPrefix(line, "!")
+		pattern = strings.TrimSpace(pattern)
+		if _, err := path.Match(pattern, ""); err != nil || pattern == "" {
+			continue
+		}
+		if deny {
+			scope.Deny = append(scope.Deny, pattern)
+		} else {
+			scope.Allow = append(scope.Allow, pattern)
+		}
+	}
+	return scope, scanner.Err()
+}
+
+// Allows reports whether the scope permits a skill name. A nil scope allows
+// everything.
+func (s *SkillScope) Allows(name string) bool {
+	if s == nil {
+		return true
+	}
+	if matchesAny(s.Deny, name) {
+		return false
+	}
+	return len(s.Allow) == 0 || matchesAny(s.Allow, name)
+}
+
+func matchesAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeSkillScope returns the .skills-scope policy for a workspace node, or nil
+// if the node has none or the file can't be read.
+func nodeSkillScope(node *workspace.WorkspaceNode) *SkillScope {
+	if node == nil {
+		return nil
+	}
+	scope, err := LoadSkillScope(node.Path)
+	if err != nil {
+		return nil
+	}
+	return scope
+}
@@ -0,0 +1,60 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grovetools/core/pkg/workspace"
+)
+
+func TestSkillScope_Allows(t *testing.T) {
+	dir := t.TempDir()
+	scope, err := LoadSkillScope(dir)
+	if err != nil || scope != nil {
+		t.Fatalf("expected no scope without a file, got %+v, %v", scope, err)
+	}
+	if !scope.Allows("anything") {
+		t.Error("nil scope must allow everything")
+	}
+
+	content := "# project policy\nreview-*\ngo-style\n!review-legacy\n"
+	if err := os.WriteFile(filepath.Join(dir, SkillScopeFileName), []byte(content), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+	scope, err = LoadSkillScope(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]bool{
+		"review-code":   true,
+		"go-style":      true,
+		"review-legacy": false,
+		"deploy":        false,
+	} {
+		if got := scope.Allows(name); got != want {
+			t.Errorf("Allows(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestListSkillSources_RespectsSkillScope(t *testing.T) {
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+	writeUserSkill(t, configHome, "allowed-skill", "")
+	writeUserSkill(t, configHome, "blocked-skill", "")
+
+	projectDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(projectDir, SkillScopeFileName), []byte("!blocked-skill\n"), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+	node := &workspace.WorkspaceNode{Name: "proj", Path: projectDir}
+
+	sources := ListSkillSourcesWithOptions(nil, node, DiscoveryOptions{ExcludeSources: []string{"notebook", "project", "playbook"}})
+	if _, ok := sources["allowed-skill"]; !ok {
+		t.Error("expected allowed-skill to be listed")
+	}
+	if _, ok := sources["blocked-skill"]; ok {
+		t.Error("expected blocked-skill to be hidden by .skills-scope")
+	}
+}
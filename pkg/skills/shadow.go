@@ -0,0 +1,102 @@
+package skills
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/grovetools/core/pkg/workspace"
+	"github.com/grovetools/skills/pkg/service"
+)
+
+// ShadowedSkill records a lower-precedence copy of a skill whose content
+// differs from the copy that won resolution.
+type ShadowedSkill struct {
+	Name     string
+	Winner   SkillSource
+	Shadowed SkillSource
+	Tier     string
+}
+
+// findShadowedSkills returns, for each resolved skill, every discovery tier
+// below the winning one that defines a skill of the same name with different
+// content, sorted by name then tier. Each tier is discovered on its own so
+// copies hidden by precedence are visible; tiers opts excludes (--exclude-source,
+// --profile) are skipped as they took no part in resolution.
+func findShadowedSkills(svc *service.Service, node *workspace.WorkspaceNode, resolved map[string]ResolvedSkill, opts DiscoveryOptions) []ShadowedSkill {
+	perTier := make(map[string]map[string]SkillSource, len(SourceTiers))
+	for _, tier := range SourceTiers {
+		if opts.excludes(svc, tier) {
+			continue
+		}
+		var exclude []string
+		for _, other := range SourceTiers {
+			if other != tier {
+				exclude = append(exclude, other)
+			}
+		}
+		perTier[tier] = ListSkillSourcesWithOptions(svc, node, DiscoveryOptions{IncludeDisabled: true, ExcludeSources: exclude})
+	}
+
+	names := make([]string, 0, len(resolved))
+	for name := range resolved {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var shadowed []ShadowedSkill
+	for _, name := range names {
+		r := resolved[name]
+		winner := SkillSource{Path: r.PhysicalPath, RelPath: r.RelPath, Type: r.SourceType}
+
+		// SourceTiers runs lowest to highest precedence; the winner's tier is
+		// the highest one that discovered this exact copy.
+		winnerTier := -1
+		for i, tier := range SourceTiers {
+			if src, ok := perTier[tier][name]; ok && src.Type == winner.Type && src.Path == winner.Path {
+				winnerTier = i
+			}
+		}
+		if winnerTier <= 0 {
+			continue
+		}
+		winnerFiles, err := readSkillSourceFiles(winner)
+		if err != nil {
+			continue
+		}
+
+		for _, tier := range SourceTiers[:winnerTier] {
+			src, ok := perTier[tier][name]
+			if !ok || (src.Type == winner.Type && src.Path == winner.Path) {
+				continue
+			}
+			files, err := readSkillSourceFiles(src)
+			if err != nil || sameSkillFiles(winnerFiles, files) {
+				continue
+			}
+			shadowed = append(shadowed, ShadowedSkill{Name: name, Winner: winner, Shadowed: src, Tier: tier})
+		}
+	}
+	return shadowed
+}
+
+// readSkillSourceFiles reads all files of a skill from the embedded FS or disk.
+func readSkillSourceFiles(src SkillSource) (map[string][]byte, error) {
+	if src.Type == SourceTypeBuiltin {
+		return readSkillFromFS(embeddedSkillsFS, src.RelPath)
+	}
+	return readSkillFromDisk(src.Path)
+}
+
+// sameSkillFiles reports whether two skill file sets have identical paths and content.
+func sameSkillFiles(a, b map[string][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for path, content := range a {
+		other, ok := b[path]
+		if !ok || !bytes.Equal(content, other) {
+			return false
+		}
+	}
+	return true
+}
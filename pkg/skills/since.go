@@ -0,0 +1,105 @@
+package skills
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/grovetools/core/git"
+)
+
+// skillsChangedSince returns the names of resolved skills whose source directory
+// contains files that differ between ref and HEAD in the git repository holding
+// that source. Changed files are mapped back to skill directories by path prefix,
+// with one `git diff` per repository. The git commands are killed when ctx is
+// done.
+//
+// Builtin skills are never reported as changed since they only change with the
+// binary. Skills whose source is not inside a git repository are always reported
+// as changed, because there is no history to compare against.
+func skillsChangedSince(ctx context.Context, resolved map[string]ResolvedSkill, ref string) (map[string]bool, error) {
+	changed := make(map[string]bool)
+	diffsByRoot := make(map[string][]string)
+
+	for name, r := range resolved {
+		if r.SourceType == SourceTypeBuiltin {
+			continue
+		}
+
+		skillDir := r.PhysicalPath
+		if resolvedDir, err := filepath.EvalSymlinks(skillDir); err == nil {
+			skillDir = resolvedDir
+		}
+
+		root, err := git.GetGitRoot(skillDir)
+		if err != nil {
+			changed[name] = true
+			continue
+		}
+
+		files, ok := diffsByRoot[root]
+		if !ok {
+			files, err = gitChangedFiles(ctx, root, ref)
+			if err != nil {
+				return nil, err
+			}
+			diffsByRoot[root] = files
+		}
+
+		relDir, err := filepath.Rel(root, skillDir)
+		if err != nil {
+			changed[name] = true
+			continue
+		}
+		prefix := filepath.ToSlash(relDir) + "/"
+		for _, f := range files {
+			if strings.HasPrefix(f, prefix) {
+				changed[name] = true
+				break
+			}
+		}
+	}
+
+	return changed, nil
+}
+
+// gitChangedFiles lists repo-relative paths changed between ref and HEAD.
+// ref is resolved to a commit first, so a value that looks like an option
+// (e.g. "--output=...") is rejected instead of reaching `git diff`.
+func gitChangedFiles(ctx context.Context, repoRoot, ref string) ([]string, error) {
+	sha, err := resolveGitCommit(ctx, repoRoot, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "-C", repoRoot, "diff", "--name-only", sha, "HEAD", "--") //nolint:gosec // G204: sha is a verified commit id
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git diff %s..HEAD failed in %s: %w", ref, repoRoot, err)
+	}
+
+	var files []string
+	for _, line := range strings.Split(string(out), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			files = append(files, line)
+		}
+	}
+	return files, nil
+}
+
+// resolveGitCommit resolves ref to the full id of the commit it names in
+// repoRoot. --end-of-options keeps git from parsing ref as a flag.
+func resolveGitCommit(ctx context.Context, repoRoot, ref string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", "-C", repoRoot, "rev-parse", "--verify", "--quiet", "--end-of-options", ref+"^{commit}") //nolint:gosec // G204: ref is passed after --end-of-options
+	out, err := cmd.Output()
+	sha := strings.TrimSpace(string(out))
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return "", ctxErr
+	}
+	if err != nil || sha == "" {
+		return "", fmt.Errorf("invalid --since ref %q: not a commit in %s", ref, repoRoot)
+	}
+	return sha, nil
+}
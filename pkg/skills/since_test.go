@@ -0,0 +1,97 @@
+package skills
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// setupSinceRepo creates a git repo with skills "a" and "a-extra" committed
+// and tagged "base", then a second commit that changes only "a".
+func setupSinceRepo(t *testing.T) string {
+	t.Helper()
+	repo := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@test.com"}, args...)...) //nolint:gosec // G204: test helper with fixed args
+		cmd.Dir = repo
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %s\n%s", args, err, out)
+		}
+	}
+	write := func(rel, content string) {
+		t.Helper()
+		path := filepath.Join(repo, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil { //nolint:gosec // G301: test
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil { //nolint:gosec // G306: test
+			t.Fatal(err)
+		}
+	}
+
+	write("skills/a/SKILL.md", "---\nname: a\ndescription: A\n---\n")
+	write("skills/a-extra/SKILL.md", "---\nname: a-extra\ndescription: A extra\n---\n")
+	git("init", "-q")
+	git("add", ".")
+	git("commit", "-q", "-m", "init")
+	git("tag", "base")
+	write("skills/a/SKILL.md", "---\nname: a\ndescription: A, changed\n---\n")
+	git("commit", "-q", "-am", "change a")
+	return repo
+}
+
+func TestGitChangedFiles(t *testing.T) {
+	repo := setupSinceRepo(t)
+
+	files, err := gitChangedFiles(context.Background(), repo, "base")
+	if err != nil {
+		t.Fatalf("gitChangedFiles: %v", err)
+	}
+	if want := []string{"skills/a/SKILL.md"}; !reflect.DeepEqual(files, want) {
+		t.Errorf("gitChangedFiles = %v, want %v", files, want)
+	}
+
+	if _, err := gitChangedFiles(context.Background(), repo, "no-such-ref"); err == nil {
+		t.Error("expected an error for an unknown ref")
+	}
+}
+
+func TestGitChangedFiles_RejectsOptionRef(t *testing.T) {
+	repo := setupSinceRepo(t)
+	out := filepath.Join(t.TempDir(), "pwned")
+
+	if _, err := gitChangedFiles(context.Background(), repo, "--output="+out); err == nil {
+		t.Error("expected an error for an option-like ref")
+	}
+	if _, err := os.Stat(out); !os.IsNotExist(err) {
+		t.Errorf("option-like ref reached git diff: %s exists", out)
+	}
+}
+
+func TestSkillsChangedSince(t *testing.T) {
+	repo := setupSinceRepo(t)
+	outside := t.TempDir()
+
+	resolved := map[string]ResolvedSkill{
+		"a":       {Name: "a", SourceType: SourceTypeUser, PhysicalPath: filepath.Join(repo, "skills", "a")},
+		"a-extra": {Name: "a-extra", SourceType: SourceTypeUser, PhysicalPath: filepath.Join(repo, "skills", "a-extra")},
+		"builtin": {Name: "builtin", SourceType: SourceTypeBuiltin, PhysicalPath: filepath.Join(repo, "skills", "a")},
+		"no-git":  {Name: "no-git", SourceType: SourceTypeUser, PhysicalPath: outside},
+	}
+
+	changed, err := skillsChangedSince(context.Background(), resolved, "base")
+	if err != nil {
+		t.Fatalf("skillsChangedSince: %v", err)
+	}
+	if want := map[string]bool{"a": true, "no-git": true}; !reflect.DeepEqual(changed, want) {
+		t.Errorf("skillsChangedSince = %v, want %v", changed, want)
+	}
+
+	if _, err := skillsChangedSince(context.Background(), resolved, "--output=x"); err == nil {
+		t.Error("expected an error for an option-like ref")
+	}
+}
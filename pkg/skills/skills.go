@@ -3,14 +3,20 @@ package skills
 import (
 	"bytes"
 	"embed"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/grovetools/skills/pkg/service"
+	"github.com/pelletier/go-toml/v2"
 	"gopkg.in/yaml.v3"
 )
 
@@ -19,12 +25,16 @@ var embeddedSkillsFS embed.FS
 
 // SkillMetadata represents the YAML frontmatter of a SKILL.md file
 type SkillMetadata struct {
-	Name          string   `yaml:"name"`
-	Description   string   `yaml:"description"`
-	Requires      []string `yaml:"requires,omitempty"`
-	Domain        string   `yaml:"domain,omitempty"`
-	SkillSequence []string `yaml:"skill_sequence,omitempty"`
-	Produces      []string `yaml:"produces,omitempty"`
+	Name          string   `yaml:"name" toml:"name" json:"name"`
+	Description   string   `yaml:"description" toml:"description" json:"description"`
+	Requires      []string `yaml:"requires,omitempty" toml:"requires,omitempty" json:"requires,omitempty"`
+	Domain        string   `yaml:"domain,omitempty" toml:"domain,omitempty" json:"domain,omitempty"`
+	SkillSequence []string `yaml:"skill_sequence,omitempty" toml:"skill_sequence,omitempty" json:"skill_sequence,omitempty"`
+	Produces      []string `yaml:"produces,omitempty" toml:"produces,omitempty" json:"produces,omitempty"`
+	Disabled      bool     `yaml:"disabled,omitempty" toml:"disabled,omitempty" json:"disabled,omitempty"`
+	Deprecated    string   `yaml:"deprecated,omitempty" toml:"deprecated,omitempty" json:"deprecated,omitempty"`
+	Aliases       []string `yaml:"aliases,omitempty" toml:"aliases,omitempty" json:"aliases,omitempty"`
+	Includes      []string `yaml:"includes,omitempty" toml:"includes,omitempty" json:"includes,omitempty"`
 }
 
 // ValidationError represents a skill validation error
@@ -40,26 +50,61 @@ func (e *ValidationError) Error() string {
 // nameRegex validates skill names: lowercase alphanumeric with single hyphen separators
 var nameRegex = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
 
+// maxSkillNameLength is the longest skill name agents accept.
+const maxSkillNameLength = 64
+
+// ValidateSkillName checks a proposed skill name against the naming rules and
+// returns the reasons it is invalid, or nil if it is valid. Surrounding
+// whitespace (e.g. a quoted "my-skill " in frontmatter) is reported on its own,
+// quoting the exact value, and the rest of the checks run on the trimmed name.
+func ValidateSkillName(name string) []string {
+	var errors []string
+	if trimmed := strings.TrimSpace(name); trimmed != name {
+		errors = append(errors, fmt.Sprintf("name %q has leading or trailing whitespace; use %q", name, trimmed))
+		name = trimmed
+	}
+	if name == "" {
+		return append(errors, "name is empty")
+	}
+	if len(name) > maxSkillNameLength {
+		errors = append(errors, fmt.Sprintf("name exceeds %d characters (got %d)", maxSkillNameLength, len(name)))
+	}
+	if !nameRegex.MatchString(name) {
+		errors = append(errors, "name must be lowercase alphanumeric with single hyphen separators (e.g., 'my-skill-name')")
+	}
+	return errors
+}
+
 // ValidateSkillContent validates the content of a SKILL.md file
 func ValidateSkillContent(content []byte, expectedName string) error {
-	metadata, err := ParseSkillFrontmatter(content)
+	metadata, _, err := ParseSkill(content)
 	if err != nil {
 		return fmt.Errorf("failed to parse SKILL.md frontmatter: %w", err)
 	}
 
 	var errors []string
 
-	if metadata.Name == "" {
+	name := strings.TrimSpace(metadata.Name)
+	if name == "" {
 		errors = append(errors, "missing required field 'name'")
 	} else {
-		if len(metadata.Name) > 64 {
-			errors = append(errors, fmt.Sprintf("name exceeds 64 characters (got %d)", len(metadata.Name)))
+		errors = append(errors, ValidateSkillName(metadata.Name)...)
+		if expectedName != "" && name != expectedName {
+			if strings.EqualFold(name, expectedName) {
+				// Case-insensitive filesystems hide this mismatch locally but it
+				// breaks on Linux, so call it out explicitly.
+				errors = append(errors, fmt.Sprintf("directory name '%s' differs from name '%s' only by case; rename the directory to '%s'", expectedName, name, name))
+			} else {
+				errors = append(errors, fmt.Sprintf("name '%s' does not match directory name '%s'", name, expectedName))
+			}
 		}
-		if !nameRegex.MatchString(metadata.Name) {
-			errors = append(errors, "name must be lowercase alphanumeric with single hyphen separators (e.g., 'my-skill-name')")
-		}
-		if expectedName != "" && metadata.Name != expectedName {
-			errors = append(errors, fmt.Sprintf("name '%s' does not match directory name '%s'", metadata.Name, expectedName))
+	}
+
+	for _, alias := range metadata.Aliases {
+		if !nameRegex.MatchString(alias) {
+			errors = append(errors, fmt.Sprintf("alias '%s' must be lowercase alphanumeric with single hyphen separators", alias))
+		} else if alias == name {
+			errors = append(errors, fmt.Sprintf("alias '%s' duplicates the skill name", alias))
 		}
 	}
 
@@ -76,28 +121,194 @@ func ValidateSkillContent(content []byte, expectedName string) error {
 	return nil
 }
 
-// ParseSkillFrontmatter extracts and parses YAML frontmatter from SKILL.md content
-func ParseSkillFrontmatter(content []byte) (*SkillMetadata, error) {
-	if !bytes.HasPrefix(content, []byte("---")) {
-		return nil, fmt.Errorf("SKILL.md must start with '---' frontmatter delimiter")
+// ParseSkill parses SKILL.md content into its frontmatter metadata and the
+// markdown body following the closing delimiter (or the JSON object), without
+// the blank remainder of the delimiter line. '---' lines further down belong
+// to the body.
+func ParseSkill(content []byte) (*SkillMetadata, string, error) {
+	frontmatter, body, format, err := splitSkillFrontmatter(content)
+	if err != nil {
+		return nil, "", err
 	}
 
-	rest := content[3:]
-	endIdx := bytes.Index(rest, []byte("\n---"))
-	if endIdx == -1 {
-		return nil, fmt.Errorf("missing closing '---' frontmatter delimiter")
+	var metadata SkillMetadata
+	if err := unmarshalFrontmatter(frontmatter, format, &metadata); err != nil {
+		return nil, "", err
 	}
+	return &metadata, string(body), nil
+}
 
-	frontmatter := rest[:endIdx]
+// ParseSkillFrontmatter extracts and parses the frontmatter from SKILL.md content.
+// YAML ('---') is the canonical format; TOML ('+++') and a leading JSON object
+// are also accepted.
+func ParseSkillFrontmatter(content []byte) (*SkillMetadata, error) {
+	frontmatter, format, err := extractFrontmatter(content)
+	if err != nil {
+		return nil, err
+	}
 
 	var metadata SkillMetadata
-	if err := yaml.Unmarshal(frontmatter, &metadata); err != nil {
-		return nil, fmt.Errorf("invalid YAML in frontmatter: %w", err)
+	if err := unmarshalFrontmatter(frontmatter, format, &metadata); err != nil {
+		return nil, err
 	}
 
 	return &metadata, nil
 }
 
+// ParseSkillFrontmatterStrict is ParseSkillFrontmatter with strict decoding:
+// fields that SkillMetadata does not define are rejected, and so are
+// duplicate keys in JSON frontmatter, which the lenient parser accepts with
+// the last one winning (YAML and TOML reject duplicates either way). YAML
+// errors carry the line number within the file.
+func ParseSkillFrontmatterStrict(content []byte) (*SkillMetadata, error) {
+	frontmatter, format, err := extractFrontmatter(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata SkillMetadata
+	switch format {
+	case frontmatterTOML:
+		err = toml.NewDecoder(bytes.NewReader(frontmatter)).DisallowUnknownFields().Decode(&metadata)
+	case frontmatterJSON:
+		if err = checkJSONDuplicateKeys(frontmatter); err == nil {
+			dec := json.NewDecoder(bytes.NewReader(frontmatter))
+			dec.DisallowUnknownFields()
+			err = dec.Decode(&metadata)
+		}
+	default:
+		dec := yaml.NewDecoder(bytes.NewReader(frontmatter))
+		dec.KnownFields(true)
+		if err = dec.Decode(&metadata); err == io.EOF {
+			err = nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s in frontmatter: %w", format, err)
+	}
+	return &metadata, nil
+}
+
+// checkJSONDuplicateKeys reports the first object key that appears twice in
+// the same JSON object, at any depth. encoding/json keeps the last value
+// silently, so strict decoding has to walk the tokens itself.
+func checkJSONDuplicateKeys(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	var walk func() error
+	walk = func() error {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('{'):
+			seen := make(map[string]bool)
+			for dec.More() {
+				keyTok, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				key, _ := keyTok.(string)
+				if seen[key] {
+					return fmt.Errorf("duplicate key %q", key)
+				}
+				seen[key] = true
+				if err := walk(); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+			return err
+		case json.Delim('['):
+			for dec.More() {
+				if err := walk(); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+			return err
+		}
+		return nil
+	}
+	return walk()
+}
+
+// frontmatterFormat identifies the syntax of a SKILL.md frontmatter block.
+type frontmatterFormat string
+
+const (
+	frontmatterYAML frontmatterFormat = "YAML"
+	frontmatterTOML frontmatterFormat = "TOML"
+	frontmatterJSON frontmatterFormat = "JSON"
+)
+
+// extractFrontmatter returns the raw frontmatter and its format. YAML is
+// delimited by '---' lines, TOML by '+++' lines, and JSON is a leading object.
+func extractFrontmatter(content []byte) ([]byte, frontmatterFormat, error) {
+	frontmatter, _, format, err := splitSkillFrontmatter(content)
+	return frontmatter, format, err
+}
+
+// splitSkillFrontmatter splits SKILL.md content into the raw frontmatter, the
+// body following the closing delimiter (or the JSON object) and the
+// frontmatter format. A blank remainder of the delimiter line is not part of
+// the body.
+func splitSkillFrontmatter(content []byte) (frontmatter, body []byte, format frontmatterFormat, err error) {
+	switch {
+	case bytes.HasPrefix(content, []byte("---")):
+		frontmatter, body, err = splitDelimited(content, "---")
+		format = frontmatterYAML
+	case bytes.HasPrefix(content, []byte("+++")):
+		frontmatter, body, err = splitDelimited(content, "+++")
+		format = frontmatterTOML
+	case bytes.HasPrefix(bytes.TrimLeft(content, " \t\r\n"), []byte("{")):
+		dec := json.NewDecoder(bytes.NewReader(content))
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, nil, "", fmt.Errorf("invalid JSON frontmatter: %w", err)
+		}
+		frontmatter, body, format = raw, content[dec.InputOffset():], frontmatterJSON
+	default:
+		return nil, nil, "", fmt.Errorf("SKILL.md must start with '---' frontmatter delimiter")
+	}
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if nl := bytes.IndexByte(body, '\n'); nl != -1 && len(bytes.TrimSpace(body[:nl])) == 0 {
+		body = body[nl+1:]
+	}
+	return frontmatter, body, format, nil
+}
+
+// splitDelimited returns the text between an opening delimiter at the start
+// of content and the next line starting with the same delimiter, and
+// everything after that closing delimiter.
+func splitDelimited(content []byte, delim string) (frontmatter, rest []byte, err error) {
+	after := content[len(delim):]
+	endIdx := bytes.Index(after, []byte("\n"+delim))
+	if endIdx == -1 {
+		return nil, nil, fmt.Errorf("missing closing '%s' frontmatter delimiter", delim)
+	}
+	return after[:endIdx], after[endIdx+1+len(delim):], nil
+}
+
+// unmarshalFrontmatter decodes raw frontmatter of the given format into v.
+func unmarshalFrontmatter(data []byte, format frontmatterFormat, v interface{}) error {
+	var err error
+	switch format {
+	case frontmatterTOML:
+		err = toml.Unmarshal(data, v)
+	case frontmatterJSON:
+		err = json.Unmarshal(data, v)
+	default:
+		err = yaml.Unmarshal(data, v)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %s in frontmatter: %w", format, err)
+	}
+	return nil
+}
+
 // getUserSkillsPath returns the path to the user-defined skills directory (~/.config/grove/skills).
 func getUserSkillsPath() string {
 	var configDir string
@@ -115,9 +326,12 @@ func getUserSkillsPath() string {
 	return filepath.Join(configDir, "grove", "skills")
 }
 
-// getUserSkillsPathWithConfig returns the user skills path.
+// getUserSkillsPathWithConfig returns the user skills path, or "" when
+// svc is embedded-only.
 func getUserSkillsPathWithConfig(svc *service.Service) string {
-	_ = svc
+	if embeddedOnly(svc) {
+		return ""
+	}
 	return getUserSkillsPath()
 }
 
@@ -135,6 +349,27 @@ func ListBuiltinSkills() []string {
 	return names
 }
 
+// QuickSkillNames returns the sorted names of builtin and user skills without
+// workspace or notebook discovery and without parsing frontmatter. It is
+// intended for latency-sensitive callers such as shell completion; svc may be
+// nil.
+func QuickSkillNames(svc *service.Service) []string {
+	found := make(map[string]string)
+	for _, name := range ListBuiltinSkills() {
+		found[name] = ""
+	}
+	if userPath := getUserSkillsPathWithConfig(svc); userPath != "" {
+		collectSkillsFromDir(svc, userPath, found)
+	}
+
+	names := make([]string, 0, len(found))
+	for name := range found {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // ListSkills returns a slice of available skill names and a map indicating their source.
 func ListSkills() ([]string, map[string]string, error) {
 	return ListSkillsWithService(nil)
@@ -157,8 +392,107 @@ func ListSkillsWithService(svc *service.Service) ([]string, map[string]string, e
 	return skillNames, skillMap, nil
 }
 
+// ReadSkillMetadata reads and parses only the SKILL.md of a resolved skill source.
+func ReadSkillMetadata(src SkillSource) (*SkillMetadata, error) {
+	content, err := readSkillMD(src)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSkillFrontmatter(content)
+}
+
+// ValidateSkillSource runs ValidateSkillContent on a skill source's SKILL.md
+// and returns the problems found, or nil if it is valid. Unreadable or
+// unparseable files yield a single error rather than being skipped.
+func ValidateSkillSource(src SkillSource, name string) []string {
+	content, err := readSkillMD(src)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	err = ValidateSkillContent(content, name)
+	if err == nil {
+		return nil
+	}
+	var verr *ValidationError
+	if errors.As(err, &verr) {
+		return verr.Errors
+	}
+	return []string{err.Error()}
+}
+
+// BuiltinSkillSource returns the source of the embedded skill with the given
+// name.
+func BuiltinSkillSource(name string) (SkillSource, bool) {
+	sources := make(map[string]SkillSource)
+	addBuiltinSkillSources(sources)
+	src, ok := sources[name]
+	return src, ok
+}
+
+// readSkillMD reads the SKILL.md of a resolved skill source.
+func readSkillMD(src SkillSource) ([]byte, error) {
+	if src.Type == SourceTypeBuiltin {
+		return fs.ReadFile(embeddedSkillsFS, filepath.Join("data/skills", src.RelPath, "SKILL.md"))
+	}
+	return os.ReadFile(filepath.Join(src.Path, "SKILL.md")) //nolint:gosec // G304: path from discovery
+}
+
+// SkillModTime returns the most recent modification time of any file in an
+// on-disk skill. Builtin skills have no meaningful mtime and return zero.
+func SkillModTime(src SkillSource) (time.Time, error) {
+	var latest time.Time
+	if src.Type == SourceTypeBuiltin {
+		return latest, nil
+	}
+	err := filepath.WalkDir(src.Path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+		return nil
+	})
+	return latest, err
+}
+
+// readSkillFile reads one file of a skill being walked. Tests replace it to
+// simulate a file disappearing mid-read.
+var readSkillFile = os.ReadFile
+
 // readSkillFromDisk reads all files for a skill from a given directory path.
+// A file that disappears during the walk while the skill directory itself
+// still exists usually means another process (e.g. a notebook sync) is
+// rewriting the skill, so the whole read is retried once before failing with a
+// "changed while being read" error.
 func readSkillFromDisk(skillRoot string) (map[string][]byte, error) {
+	skillFiles, err := walkSkillFiles(skillRoot)
+	if err != nil && errors.Is(err, fs.ErrNotExist) && isDir(skillRoot) {
+		skillFiles, err = walkSkillFiles(skillRoot)
+		if err != nil && errors.Is(err, fs.ErrNotExist) && isDir(skillRoot) {
+			return nil, fmt.Errorf("skill at %s changed while being read: %w", skillRoot, err)
+		}
+	}
+	if err != nil {
+		if _, statErr := os.Stat(skillRoot); os.IsNotExist(statErr) {
+			return nil, fmt.Errorf("skill not found at %s", skillRoot)
+		}
+		// Name the file that failed (e.g. permission denied) rather than
+		// reporting the whole skill as missing.
+		return nil, fmt.Errorf("failed to read skill at %s: %w", skillRoot, err)
+	}
+	if len(skillFiles) == 0 {
+		return nil, fmt.Errorf("skill directory %s is empty — add a SKILL.md", skillRoot)
+	}
+	return skillFiles, nil
+}
+
+// walkSkillFiles reads every file under skillRoot, keyed by relative path.
+func walkSkillFiles(skillRoot string) (map[string][]byte, error) {
 	skillFiles := make(map[string][]byte)
 	err := filepath.WalkDir(skillRoot, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
@@ -168,17 +502,20 @@ func readSkillFromDisk(skillRoot string) (map[string][]byte, error) {
 			return nil
 		}
 		relPath, _ := filepath.Rel(skillRoot, path)
-		content, err := os.ReadFile(path) //nolint:gosec // G304: path from WalkDir
+		content, err := readSkillFile(path)
 		if err != nil {
 			return err
 		}
 		skillFiles[relPath] = content
 		return nil
 	})
-	if err != nil || len(skillFiles) == 0 {
-		return nil, fmt.Errorf("skill not found at %s", skillRoot)
-	}
-	return skillFiles, nil
+	return skillFiles, err
+}
+
+// isDir reports whether path exists and is a directory.
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
 }
 
 // readSkillFromFS reads all files for a skill from an fs.FS.
@@ -0,0 +1,312 @@
+package skills
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadSkillFromDisk_MissingDir(t *testing.T) {
+	_, err := readSkillFromDisk(filepath.Join(t.TempDir(), "missing"))
+	if err == nil || !strings.Contains(err.Error(), "skill not found") {
+		t.Fatalf("expected 'skill not found' error, got: %v", err)
+	}
+}
+
+func TestReadSkillFromDisk_EmptyDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "empty-skill")
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gosec // G301: test
+		t.Fatal(err)
+	}
+
+	_, err := readSkillFromDisk(dir)
+	if err == nil {
+		t.Fatal("expected error for empty skill directory")
+	}
+	if !strings.Contains(err.Error(), "is empty") {
+		t.Errorf("expected empty-directory error, got: %v", err)
+	}
+}
+
+func TestReadSkillFromDisk_RetriesVanishedFile(t *testing.T) {
+	dir := writeUserSkill(t, t.TempDir(), "busy-skill", "")
+	orig := readSkillFile
+	t.Cleanup(func() { readSkillFile = orig })
+
+	failures := 1
+	readSkillFile = func(name string) ([]byte, error) {
+		if failures > 0 {
+			failures--
+			return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+		}
+		return orig(name)
+	}
+	files, err := readSkillFromDisk(dir)
+	if err != nil {
+		t.Fatalf("expected the retry to succeed, got: %v", err)
+	}
+	if _, ok := files["SKILL.md"]; !ok {
+		t.Errorf("expected SKILL.md after retry, got %v", files)
+	}
+
+	failures = 2
+	_, err = readSkillFromDisk(dir)
+	if err == nil || !strings.Contains(err.Error(), "changed while being read") {
+		t.Fatalf("expected 'changed while being read' error, got: %v", err)
+	}
+}
+
+func TestReadSkillFromDisk_UnreadableFile(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("file permissions are not enforced for root")
+	}
+	dir := filepath.Join(t.TempDir(), "locked-skill")
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gosec // G301: test
+		t.Fatal(err)
+	}
+	locked := filepath.Join(dir, "secret.md")
+	if err := os.WriteFile(locked, []byte("x"), 0o000); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := readSkillFromDisk(dir)
+	if err == nil || !strings.Contains(err.Error(), locked) {
+		t.Fatalf("expected error naming %s, got: %v", locked, err)
+	}
+	if strings.Contains(err.Error(), "skill not found") {
+		t.Errorf("unreadable file reported as missing skill: %v", err)
+	}
+}
+
+func TestValidateSkillContent_DirectoryCaseMismatch(t *testing.T) {
+	content := []byte("---\nname: my-skill\ndescription: Test skill\n---\n")
+
+	err := ValidateSkillContent(content, "My-Skill")
+	if err == nil {
+		t.Fatal("expected mixed-case directory name to fail validation")
+	}
+	if !strings.Contains(err.Error(), "only by case") {
+		t.Errorf("expected case-mismatch message, got: %v", err)
+	}
+
+	if err := ValidateSkillContent(content, "my-skill"); err != nil {
+		t.Errorf("expected exact match to pass, got: %v", err)
+	}
+
+	err = ValidateSkillContent(content, "other-skill")
+	if err == nil || strings.Contains(err.Error(), "only by case") {
+		t.Errorf("expected plain mismatch message, got: %v", err)
+	}
+}
+
+func TestParseSkillFrontmatter_Formats(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"yaml", "---\nname: demo\ndescription: A demo\nrequires: [base]\n---\n\nBody\n"},
+		{"toml", "+++\nname = \"demo\"\ndescription = \"A demo\"\nrequires = [\"base\"]\n+++\n\nBody\n"},
+		{"json", "{\n  \"name\": \"demo\",\n  \"description\": \"A demo\",\n  \"requires\": [\"base\"]\n}\n\nBody\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta, err := ParseSkillFrontmatter([]byte(tt.content))
+			if err != nil {
+				t.Fatalf("ParseSkillFrontmatter: %v", err)
+			}
+			if meta.Name != "demo" || meta.Description != "A demo" {
+				t.Errorf("unexpected metadata: %+v", meta)
+			}
+			if len(meta.Requires) != 1 || meta.Requires[0] != "base" {
+				t.Errorf("expected requires [base], got %v", meta.Requires)
+			}
+		})
+	}
+}
+
+func TestParseSkill(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		body    string
+	}{
+		{"lf", "---\nname: demo\ndescription: A demo\n---\n# Title\n", "# Title\n"},
+		{"crlf", "---\r\nname: demo\r\ndescription: A demo\r\n---\r\n# Title\r\n", "# Title\r\n"},
+		{"separators in body", "---\nname: demo\ndescription: A demo\n---\nIntro\n\n---\n\nMore\n---\n", "Intro\n\n---\n\nMore\n---\n"},
+		{"empty body", "---\nname: demo\ndescription: A demo\n---", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta, body, err := ParseSkill([]byte(tt.content))
+			if err != nil {
+				t.Fatalf("ParseSkill: %v", err)
+			}
+			if meta.Name != "demo" || meta.Description != "A demo" {
+				t.Errorf("unexpected metadata: %+v", meta)
+			}
+			if body != tt.body {
+				t.Errorf("body = %q, want %q", body, tt.body)
+			}
+		})
+	}
+
+	if _, _, err := ParseSkill([]byte("# Title\n")); err == nil {
+		t.Error("expected an error for content without frontmatter")
+	}
+}
+
+func TestParseSkillFrontmatter_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"no frontmatter", "# Title\n", "must start with '---'"},
+		{"unclosed toml", "+++\nname = \"demo\"\n", "missing closing '+++'"},
+		{"bad toml", "+++\nname = \n+++\n", "invalid TOML"},
+		{"bad json", "{\"name\": }\n", "invalid JSON"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSkillFrontmatter([]byte(tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got: %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestValidateSkillContent_Aliases(t *testing.T) {
+	content := []byte("---\nname: my-skill\ndescription: test\naliases: [ms, Bad_Alias, my-skill]\n---\n")
+	err := ValidateSkillContent(content, "my-skill")
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+	if len(verr.Errors) != 2 {
+		t.Errorf("expected 2 alias errors, got %v", verr.Errors)
+	}
+}
+
+func TestSkillModTime(t *testing.T) {
+	dir := writeUserSkill(t, t.TempDir(), "timed-skill", "")
+	old := time.Now().Add(-48 * time.Hour).Truncate(time.Second)
+	recent := time.Now().Add(-time.Hour).Truncate(time.Second)
+	if err := os.Chtimes(filepath.Join(dir, "SKILL.md"), old, old); err != nil {
+		t.Fatal(err)
+	}
+	part := filepath.Join(dir, "notes.md")
+	if err := os.WriteFile(part, []byte("notes\n"), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(part, recent, recent); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := SkillModTime(SkillSource{Path: dir, Type: SourceTypeUser})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(recent) {
+		t.Errorf("SkillModTime = %v, want newest file time %v", got, recent)
+	}
+
+	if got, _ := SkillModTime(SkillSource{Type: SourceTypeBuiltin}); !got.IsZero() {
+		t.Errorf("expected zero time for builtin, got %v", got)
+	}
+}
+
+func TestParseSkillFrontmatterStrict(t *testing.T) {
+	valid := []byte("---\nname: strict-skill\ndescription: d\n---\nBody\n")
+	if _, err := ParseSkillFrontmatterStrict(valid); err != nil {
+		t.Fatalf("expected valid frontmatter to parse, got %v", err)
+	}
+
+	unknown := []byte("---\nname: strict-skill\ndescription: d\nauthor: someone\n---\n")
+	if _, err := ParseSkillFrontmatter(unknown); err != nil {
+		t.Fatalf("lenient parse should accept unknown fields, got %v", err)
+	}
+	_, err := ParseSkillFrontmatterStrict(unknown)
+	if err == nil || !strings.Contains(err.Error(), "line 4") || !strings.Contains(err.Error(), "author") {
+		t.Errorf("expected unknown field error at line 4, got %v", err)
+	}
+
+	duplicate := []byte("{\n  \"name\": \"strict-skill\",\n  \"description\": \"d\",\n  \"name\": \"other\"\n}\nBody\n")
+	meta, err := ParseSkillFrontmatter(duplicate)
+	if err != nil || meta.Name != "other" {
+		t.Fatalf("lenient parse should accept duplicate JSON keys with the last winning, got %+v (err=%v)", meta, err)
+	}
+	if _, err := ParseSkillFrontmatterStrict(duplicate); err == nil || !strings.Contains(err.Error(), `duplicate key "name"`) {
+		t.Errorf("expected duplicate key error, got %v", err)
+	}
+
+	nested := []byte("{\"name\": \"strict-skill\", \"description\": \"d\", \"aliases\": [{\"a\": 1, \"a\": 2}]}\n")
+	if err := checkJSONDuplicateKeys(nested[:len(nested)-1]); err == nil {
+		t.Error("expected a nested duplicate key to be reported")
+	}
+}
+
+func TestValidateSkillSource(t *testing.T) {
+	dir := t.TempDir()
+	good := writeUserSkill(t, dir, "good-skill", "")
+	if errs := ValidateSkillSource(SkillSource{Path: good, Type: SourceTypeUser}, "good-skill"); errs != nil {
+		t.Errorf("expected valid skill, got %v", errs)
+	}
+	if errs := ValidateSkillSource(SkillSource{Path: good, Type: SourceTypeUser}, "other-name"); len(errs) != 1 {
+		t.Errorf("expected one name mismatch error, got %v", errs)
+	}
+
+	broken := filepath.Join(dir, "broken-skill")
+	if err := os.MkdirAll(broken, 0o755); err != nil { //nolint:gosec // G301: test
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(broken, "SKILL.md"), []byte("no frontmatter\n"), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+	if errs := ValidateSkillSource(SkillSource{Path: broken, Type: SourceTypeUser}, "broken-skill"); len(errs) != 1 {
+		t.Errorf("expected parse failure to be reported, got %v", errs)
+	}
+
+	src, ok := BuiltinSkillSource("explain-with-analogy")
+	if !ok {
+		t.Fatal("expected builtin source for explain-with-analogy")
+	}
+	if errs := ValidateSkillSource(src, "explain-with-analogy"); errs != nil {
+		t.Errorf("expected builtin skill to be valid, got %v", errs)
+	}
+}
+
+func TestValidateSkillName(t *testing.T) {
+	for name, want := range map[string]int{
+		"my-skill":              0,
+		"a1":                    0,
+		"":                      1,
+		"My_Skill":              1,
+		"double--hyphen":        1,
+		"-leading":              1,
+		strings.Repeat("a", 65): 1,
+		strings.Repeat("A", 65): 2,
+		" my-skill":             1,
+		"my-skill\t":            1,
+		" My_Skill ":            2,
+	} {
+		if got := ValidateSkillName(name); len(got) != want {
+			t.Errorf("ValidateSkillName(%q) = %v, want %d error(s)", name, got, want)
+		}
+	}
+}
+
+func TestValidateSkillContent_NameWhitespace(t *testing.T) {
+	content := []byte("---\nname: \"my-skill \"\ndescription: test\n---\nbody\n")
+	err := ValidateSkillContent(content, "my-skill")
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected a ValidationError, got %v", err)
+	}
+	if len(verr.Errors) != 1 || !strings.Contains(verr.Errors[0], `"my-skill "`) {
+		t.Errorf("expected only a whitespace error quoting the value, got %v", verr.Errors)
+	}
+}
@@ -0,0 +1,66 @@
+package skills
+
+import (
+	"path/filepath"
+
+	"github.com/grovetools/core/pkg/workspace"
+	"github.com/grovetools/skills/pkg/service"
+)
+
+// SourceRoot is a directory scanned for skills and the discovery tier (see
+// SourceTiers) it belongs to.
+type SourceRoot struct {
+	Tier string
+	Dir  string
+}
+
+// SkillSourceRoots returns the directories ListSkillSources scans for node, in
+// precedence order (lowest first). Tiers with no directory are omitted; the
+// builtin tier has none.
+func SkillSourceRoots(svc *service.Service, node *workspace.WorkspaceNode) []SourceRoot {
+	if embeddedOnly(svc) {
+		return nil
+	}
+	var roots []SourceRoot
+	if userPath := getUserSkillsPathWithConfig(svc); userPath != "" {
+		roots = append(roots, SourceRoot{Tier: "user", Dir: userPath})
+	}
+	for _, dir := range notebookSkillDirs(svc) {
+		roots = append(roots, SourceRoot{Tier: "notebook", Dir: dir})
+	}
+	if node != nil && node.RootEcosystemPath != "" {
+		if ecoDir := getEcosystemSkillsDir(svc, node); ecoDir != "" {
+			roots = append(roots, SourceRoot{Tier: "ecosystem", Dir: ecoDir})
+		}
+	}
+	if node != nil {
+		if projDir := getProjectSkillsDir(svc, node); projDir != "" {
+			roots = append(roots, SourceRoot{Tier: "project", Dir: projDir})
+		}
+		dirs := GetPlaybookSearchDirs(node.Path)
+		for i := len(dirs) - 1; i >= 0; i-- {
+			roots = append(roots, SourceRoot{Tier: "playbook", Dir: dirs[i]})
+		}
+	}
+	return roots
+}
+
+// SourceRootFor returns the root in roots that contains the on-disk source
+// path, preferring the most specific (deepest) match, and the source path
+// with symlinks resolved. Builtin sources and unmatched paths yield a zero
+// SourceRoot.
+func SourceRootFor(roots []SourceRoot, src SkillSource) (SourceRoot, string) {
+	if src.Type == SourceTypeBuiltin {
+		return SourceRoot{Tier: "builtin"}, ""
+	}
+	resolved := canonicalPath(src.Path)
+	var best SourceRoot
+	bestLen := -1
+	for _, root := range roots {
+		dir := canonicalPath(root.Dir)
+		if pathWithin(resolved, dir) && len(dir) >= bestLen {
+			best, bestLen = root, len(dir)
+		}
+	}
+	return best, filepath.Clean(resolved)
+}
@@ -0,0 +1,37 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSourceRootFor(t *testing.T) {
+	dir := t.TempDir()
+	eco := filepath.Join(dir, "eco", "skills")
+	proj := filepath.Join(dir, "eco", "skills", "proj")
+	skill := filepath.Join(proj, "nested", "my-skill")
+	if err := os.MkdirAll(skill, 0o755); err != nil { //nolint:gosec // G301: test
+		t.Fatal(err)
+	}
+	link := filepath.Join(dir, "linked")
+	if err := os.Symlink(skill, link); err != nil {
+		t.Fatal(err)
+	}
+	roots := []SourceRoot{{Tier: "ecosystem", Dir: eco}, {Tier: "project", Dir: proj}}
+
+	root, resolved := SourceRootFor(roots, SkillSource{Path: link, Type: SourceTypeProject})
+	if root.Tier != "project" {
+		t.Errorf("expected deepest root to win, got %+v", root)
+	}
+	if want := canonicalPath(skill); resolved != want {
+		t.Errorf("resolved = %s, want %s", resolved, want)
+	}
+
+	if root, _ := SourceRootFor(roots, SkillSource{Path: t.TempDir(), Type: SourceTypeUser}); root.Tier != "" {
+		t.Errorf("expected no root for unrelated path, got %+v", root)
+	}
+	if root, _ := SourceRootFor(roots, SkillSource{Type: SourceTypeBuiltin}); root.Tier != "builtin" {
+		t.Errorf("expected builtin tier, got %+v", root)
+	}
+}
@@ -0,0 +1,103 @@
+package skills
+
+import "strings"
+
+// StripSkillContent removes HTML comments and collapses runs of blank lines in
+// the body of a SKILL.md to reduce the tokens an agent has to read. The
+// frontmatter (YAML, TOML or JSON) and fenced code blocks (``` or ~~~) are
+// copied through unchanged.
+func StripSkillContent(content []byte) []byte {
+	header, body := []byte(nil), content
+	if _, rest, _, err := splitSkillFrontmatter(content); err == nil {
+		header, body = content[:len(content)-len(rest)], rest
+	}
+
+	lines := strings.Split(string(body), "\n")
+	out := make([]string, 0, len(lines))
+	var fence string
+	inComment := false
+	blankRun := 0
+
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		if fence != "" {
+			out = append(out, line)
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+
+		if !inComment {
+			if marker := fenceMarker(trimmed); marker != "" {
+				fence = marker
+				blankRun = 0
+				out = append(out, line)
+				continue
+			}
+		}
+
+		stripped, stillInComment := stripHTMLComments(line, inComment)
+		hadComment := inComment || stillInComment || stripped != line
+		inComment = stillInComment
+
+		if strings.TrimSpace(stripped) == "" {
+			// Lines that only held a comment disappear entirely.
+			if hadComment {
+				continue
+			}
+			blankRun++
+			if blankRun > 1 {
+				continue
+			}
+			out = append(out, "")
+			continue
+		}
+
+		blankRun = 0
+		out = append(out, stripped)
+	}
+
+	result := make([]byte, 0, len(content))
+	result = append(result, header...)
+	result = append(result, strings.Join(out, "\n")...)
+	return result
+}
+
+// fenceMarker returns the opening fence (``` or ~~~) if line starts a fenced code block.
+func fenceMarker(trimmed string) string {
+	for _, marker := range []string{"```", "~~~"} {
+		if strings.HasPrefix(trimmed, marker) {
+			return marker
+		}
+	}
+	return ""
+}
+
+// stripHTMLComments removes <!-- ... --> spans from a single line. inComment
+// reports whether the line starts inside a comment opened on an earlier line;
+// the returned bool reports whether a comment is still open at end of line.
+func stripHTMLComments(line string, inComment bool) (string, bool) {
+	var b strings.Builder
+	rest := line
+	for {
+		if inComment {
+			end := strings.Index(rest, "-->")
+			if end == -1 {
+				return b.String(), true
+			}
+			rest = rest[end+len("-->"):]
+			inComment = false
+			continue
+		}
+		start := strings.Index(rest, "<!--")
+		if start == -1 {
+			b.WriteString(rest)
+			return b.String(), false
+		}
+		b.WriteString(rest[:start])
+		rest = rest[start+len("<!--"):]
+		inComment = true
+	}
+}
@@ -0,0 +1,40 @@
+package skills
+
+import "testing"
+
+func TestStripSkillContent(t *testing.T) {
+	input := "---\nname: demo\n# <!-- not a comment in yaml -->\n---\n\n" +
+		"Intro <!-- inline note --> text.\n" +
+		"<!-- whole line -->\n" +
+		"\n\n\n" +
+		"<!--\nmulti\nline\n-->\n" +
+		"After.\n" +
+		"```html\n<!-- kept in code -->\n\n\n```\n" +
+		"Done.\n"
+
+	want := "---\nname: demo\n# <!-- not a comment in yaml -->\n---\n\n" +
+		"Intro  text.\n" +
+		"\n" +
+		"After.\n" +
+		"```html\n<!-- kept in code -->\n\n\n```\n" +
+		"Done.\n"
+
+	if got := string(StripSkillContent([]byte(input))); got != want {
+		t.Errorf("StripSkillContent mismatch\ngot:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestStripSkillContent_NoFrontmatter(t *testing.T) {
+	input := "Body <!-- x -->\n"
+	if got := string(StripSkillContent([]byte(input))); got != "Body \n" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestStripSkillContent_TOMLFrontmatter(t *testing.T) {
+	input := "+++\nname = \"demo\"\ndescription = \"Keeps <!-- this --> text\"\n+++\n\nBody <!-- x -->\n"
+	want := "+++\nname = \"demo\"\ndescription = \"Keeps <!-- this --> text\"\n+++\n\nBody \n"
+	if got := string(StripSkillContent([]byte(input))); got != want {
+		t.Errorf("StripSkillContent mismatch\ngot:\n%q\nwant:\n%q", got, want)
+	}
+}
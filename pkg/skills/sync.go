@@ -1,10 +1,13 @@
 package skills
 
 import (
+	"context"
 	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/grovetools/core/config"
@@ -34,8 +37,9 @@ type SkillSource struct {
 }
 
 // addSkillSourceSafely adds a skill source, handling duplicates by preferring the shallowest path
-// within the same source type. Across different source types, later calls overwrite earlier ones
-// (callers are responsible for calling in precedence order).
+// within the same source type; at equal depth the first one added wins. Across different source
+// types, later calls overwrite earlier ones (callers are responsible for calling in precedence
+// order).
 func addSkillSourceSafely(sources map[string]SkillSource, name string, newSource SkillSource) {
 	existing, ok := sources[name]
 	if !ok {
@@ -56,12 +60,14 @@ func addSkillSourceSafely(sources map[string]SkillSource, name string, newSource
 
 // SyncSkillsToDirectory copies all discoverable skills to a destination directory.
 // Skills are collected from multiple sources with the following precedence (higher wins):
-//  1. User skills from ~/.config/grove/skills
-//  2. Ecosystem skills from the notebook (if project is part of an ecosystem)
-//  3. Project skills from the notebook (highest precedence)
+//  1. Built-in skills embedded in the binary (lowest precedence)
+//  2. User skills from ~/.config/grove/skills
+//  3. Ecosystem skills from the notebook (if project is part of an ecosystem)
+//  4. Project skills from the notebook (highest precedence)
 //
 // Supports nested skill directories: skills/kitchen/prep/SKILL.md resolves as skill "prep"
-// and is synced flattened to destDir/prep/.
+// and is synced flattened to destDir/prep/. Skills excluded by the node's
+// .skills-scope file are not synced.
 func SyncSkillsToDirectory(svc *service.Service, node *workspace.WorkspaceNode, destDir string) (int, error) {
 	if node == nil {
 		return 0, fmt.Errorf("workspace node is required")
@@ -72,20 +78,40 @@ func SyncSkillsToDirectory(svc *service.Service, node *workspace.WorkspaceNode,
 
 	userSkillsPath := getUserSkillsPathWithConfig(svc)
 	if userSkillsPath != "" {
-		collectSkillsFromDir(userSkillsPath, skillSources)
+		collectSkillsFromDir(svc, userSkillsPath, skillSources)
 	}
 
-	if node.RootEcosystemPath != "" {
+	if node.RootEcosystemPath != "" && !embeddedOnly(svc) {
 		if ecoDir := getEcosystemSkillsDir(svc, node); ecoDir != "" {
-			collectSkillsFromDir(ecoDir, skillSources)
+			collectSkillsFromDir(svc, ecoDir, skillSources)
 		}
 	}
 
-	if projDir := getProjectSkillsDir(svc, node); projDir != "" {
-		collectSkillsFromDir(projDir, skillSources)
+	if projDir := getProjectSkillsDir(svc, node); projDir != "" && !embeddedOnly(svc) {
+		collectSkillsFromDir(svc, projDir, skillSources)
 	}
 
-	if len(skillSources) == 0 {
+	// Builtins are the lowest tier: only used when no disk source has the name.
+	builtinSources := make(map[string]SkillSource)
+	addBuiltinSkillSources(builtinSources)
+	for name := range skillSources {
+		delete(builtinSources, name)
+	}
+
+	if scope := nodeSkillScope(node); scope != nil {
+		for name := range skillSources {
+			if !scope.Allows(name) {
+				delete(skillSources, name)
+			}
+		}
+		for name := range builtinSources {
+			if !scope.Allows(name) {
+				delete(builtinSources, name)
+			}
+		}
+	}
+
+	if len(skillSources) == 0 && len(builtinSources) == 0 {
 		return 0, nil
 	}
 
@@ -97,12 +123,25 @@ func SyncSkillsToDirectory(svc *service.Service, node *workspace.WorkspaceNode,
 	var lastErr error
 	for skillName, srcPath := range skillSources {
 		destPath := filepath.Join(destDir, skillName)
+		if err := checkSourceOutsideDest(srcPath, destPath); err != nil {
+			lastErr = err
+			continue
+		}
 		if err := corefs.CopyDir(srcPath, destPath); err != nil {
 			lastErr = fmt.Errorf("failed to sync skill %s: %w", skillName, err)
 		} else {
 			syncedCount++
 		}
 	}
+	for skillName, src := range builtinSources {
+		r := ResolvedSkill{Name: skillName, SourceType: SourceTypeBuiltin, PhysicalPath: src.Path, RelPath: src.RelPath}
+		// Merge keeps the overwrite-in-place behavior used for disk skills above.
+		if err := installResolvedSkill(r, filepath.Join(destDir, skillName), SyncOptions{Merge: true}); err != nil {
+			lastErr = fmt.Errorf("failed to sync skill %s: %w", skillName, err)
+		} else {
+			syncedCount++
+		}
+	}
 
 	return syncedCount, lastErr
 }
@@ -110,60 +149,84 @@ func SyncSkillsToDirectory(svc *service.Service, node *workspace.WorkspaceNode,
 // collectSkillsFromDir recursively scans a directory for SKILL.md files and adds them to the map.
 // The map key is the leaf directory name (skill name), flattening any nesting.
 // Directories without SKILL.md are treated as organizational folders and skipped.
-func collectSkillsFromDir(dir string, skillSources map[string]string) {
-	if _, err := os.Stat(dir); os.IsNotExist(err) {
-		return
-	}
-
-	_ = filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
-		if err != nil || d.IsDir() || d.Name() != "SKILL.md" {
-			return nil
-		}
-
-		skillPath := filepath.Dir(path)
-		relDir, _ := filepath.Rel(dir, skillPath)
-		// Prevent infinite scanning of deeply nested directories
-		if strings.Count(filepath.ToSlash(relDir), "/") > 4 {
-			return nil
-		}
-
-		skillName := filepath.Base(skillPath)
-		skillSources[skillName] = skillPath
-		return nil
+func collectSkillsFromDir(svc *service.Service, dir string, skillSources map[string]string) {
+	walkSkillTree(svc, dir, func(skillPath, _ string) {
+		skillSources[filepath.Base(skillPath)] = skillPath
 	})
 }
 
 // addSkillSources recursively discovers skills from a directory and adds them to the sources map.
 // Skill name is always the leaf directory containing SKILL.md.
 // Directories without SKILL.md are organizational folders — they are recursed into but not added.
-func addSkillSources(dir string, sourceType SourceType, sources map[string]SkillSource) {
-	if _, err := os.Stat(dir); os.IsNotExist(err) {
-		return
-	}
-
-	_ = filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
-		if err != nil || d.IsDir() || d.Name() != "SKILL.md" {
-			return nil
-		}
-
-		skillPath := filepath.Dir(path)
-		relDir, _ := filepath.Rel(dir, skillPath)
-		if strings.Count(filepath.ToSlash(relDir), "/") > 4 {
-			return nil
-		}
-
+func addSkillSources(svc *service.Service, dir string, sourceType SourceType, sources map[string]SkillSource) {
+	walkSkillTree(svc, dir, func(skillPath, relDir string) {
 		// Skill name is the leaf directory containing SKILL.md
-		skillName := filepath.Base(skillPath)
-
-		addSkillSourceSafely(sources, skillName, SkillSource{
+		addSkillSourceSafely(sources, filepath.Base(skillPath), SkillSource{
 			Path:    skillPath,
 			RelPath: relDir,
 			Type:    sourceType,
 		})
-		return nil
 	})
 }
 
+// maxSkillNesting is the deepest number of folders a skill may sit below a
+// source root, preventing unbounded scans of deeply nested trees.
+const maxSkillNesting = 5
+
+// followSymlinks reports whether discovery descends into symlinked
+// directories below a source root (e.g. notebook skills linked in from
+// elsewhere). Roots themselves are always followed, and symlink loops are
+// detected either way. A nil svc follows them.
+func followSymlinks(svc *service.Service) bool {
+	return svc == nil || !svc.NoFollowSymlinks
+}
+
+// walkSkillTree calls fn for every directory under root that contains a
+// SKILL.md, with its path relative to root. Symlinked directories are followed
+// unless svc disables it; each real directory is visited at most once so
+// symlink loops terminate.
+func walkSkillTree(svc *service.Service, root string, fn func(skillPath, relDir string)) {
+	follow := followSymlinks(svc)
+	visited := make(map[string]bool)
+	var walk func(dir string, depth int)
+	walk = func(dir string, depth int) {
+		real, err := filepath.EvalSymlinks(dir)
+		if err != nil || visited[real] {
+			return
+		}
+		visited[real] = true
+
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return
+		}
+		for _, entry := range entries {
+			path := filepath.Join(dir, entry.Name())
+			isDir := entry.IsDir()
+			if entry.Type()&fs.ModeSymlink != 0 {
+				info, err := os.Stat(path)
+				if err != nil {
+					continue
+				}
+				isDir = info.IsDir()
+				if isDir && !follow {
+					continue
+				}
+			}
+			switch {
+			case isDir:
+				if depth < maxSkillNesting {
+					walk(path, depth+1)
+				}
+			case entry.Name() == "SKILL.md":
+				relDir, _ := filepath.Rel(root, dir)
+				fn(dir, relDir)
+			}
+		}
+	}
+	walk(root, 0)
+}
+
 // addBuiltinSkillSources adds embedded/built-in skills to the sources map.
 // Supports nested builtin skills by walking the embedded FS recursively.
 func addBuiltinSkillSources(sources map[string]SkillSource) {
@@ -189,6 +252,88 @@ func addBuiltinSkillSources(sources map[string]SkillSource) {
 	})
 }
 
+// DiscoveryOptions tunes which skills ListSkillSourcesWithOptions returns.
+type DiscoveryOptions struct {
+	// IncludeDisabled keeps skills whose winning SKILL.md sets `disabled: true`.
+	IncludeDisabled bool
+
+	// ExcludeSources skips whole discovery tiers by name (see SourceTiers).
+	// Remaining tiers keep their normal precedence.
+	ExcludeSources []string
+}
+
+// embeddedOnly reports whether svc restricts discovery to the builtin skills
+// embedded in the binary, ignoring user, notebook, ecosystem, project and
+// playbook sources. A nil svc doesn't.
+func embeddedOnly(svc *service.Service) bool {
+	return svc != nil && svc.EmbeddedOnly
+}
+
+// notebookOverride returns the notebook definition svc forces discovery to
+// use instead of the one the config rules pick for each workspace, or "" for
+// the default.
+func notebookOverride(svc *service.Service) string {
+	if svc == nil {
+		return ""
+	}
+	return svc.Notebook
+}
+
+// ValidateNotebookName reports an error unless cfg defines a notebook named name.
+func ValidateNotebookName(cfg *config.Config, name string) error {
+	var known []string
+	if cfg != nil && cfg.Notebooks != nil {
+		if nb, ok := cfg.Notebooks.Definitions[name]; ok && nb != nil {
+			return nil
+		}
+		for n := range cfg.Notebooks.Definitions {
+			known = append(known, n)
+		}
+	}
+	if len(known) == 0 {
+		return fmt.Errorf("unknown notebook %q: no notebooks are defined in config", name)
+	}
+	sort.Strings(known)
+	return fmt.Errorf("unknown notebook %q (defined: %s)", name, strings.Join(known, ", "))
+}
+
+// notebookFor returns the notebook name to resolve node's skills with.
+func notebookFor(svc *service.Service, node *workspace.WorkspaceNode) string {
+	if name := notebookOverride(svc); name != "" {
+		return name
+	}
+	return node.NotebookName
+}
+
+// withNotebook returns node, or a copy of it using svc's notebook override.
+func withNotebook(svc *service.Service, node *workspace.WorkspaceNode) *workspace.WorkspaceNode {
+	name := notebookOverride(svc)
+	if name == "" || node.NotebookName == name {
+		return node
+	}
+	n := *node
+	n.NotebookName = name
+	return &n
+}
+
+// SourceTiers lists the discovery tiers in precedence order (lowest first),
+// as accepted by DiscoveryOptions.ExcludeSources.
+var SourceTiers = []string{"builtin", "user", "notebook", "ecosystem", "project", "playbook"}
+
+// excludes reports whether tier is listed in ExcludeSources, or is a disk
+// tier while svc is embedded-only.
+func (o DiscoveryOptions) excludes(svc *service.Service, tier string) bool {
+	if embeddedOnly(svc) && tier != "builtin" {
+		return true
+	}
+	for _, s := range o.ExcludeSources {
+		if s == tier {
+			return true
+		}
+	}
+	return false
+}
+
 // ListSkillSources returns a map of skill names to their source paths.
 // Skills are listed in precedence order (later sources override earlier):
 //  1. Built-in skills (embedded in binary)
@@ -196,37 +341,75 @@ func addBuiltinSkillSources(sources map[string]SkillSource) {
 //  3. Notebook skills (from all configured notebook workspaces)
 //  4. Ecosystem skills (from notebook)
 //  5. Project skills (from notebook)
+//
+// Skills marked `disabled: true` are omitted; use ListSkillSourcesWithOptions
+// to include them.
 func ListSkillSources(svc *service.Service, node *workspace.WorkspaceNode) map[string]SkillSource {
+	return ListSkillSourcesWithOptions(svc, node, DiscoveryOptions{})
+}
+
+// ListSkillSourcesWithOptions is ListSkillSources with explicit discovery options.
+// When node has a .skills-scope file, skills it does not allow are dropped.
+func ListSkillSourcesWithOptions(svc *service.Service, node *workspace.WorkspaceNode, opts DiscoveryOptions) map[string]SkillSource {
 	sources := make(map[string]SkillSource)
 
-	addBuiltinSkillSources(sources)
+	if !opts.excludes(svc, "builtin") {
+		addBuiltinSkillSources(sources)
+	}
 
-	if userPath := getUserSkillsPathWithConfig(svc); userPath != "" {
-		addSkillSources(userPath, SourceTypeUser, sources)
+	if userPath := getUserSkillsPathWithConfig(svc); userPath != "" && !opts.excludes(svc, "user") {
+		addSkillSources(svc, userPath, SourceTypeUser, sources)
 	}
 
-	addNotebookSkillSources(svc, sources)
+	if !opts.excludes(svc, "notebook") {
+		addNotebookSkillSources(svc, sources)
+	}
 
-	if node != nil && node.RootEcosystemPath != "" {
+	if node != nil && node.RootEcosystemPath != "" && !opts.excludes(svc, "ecosystem") {
 		if ecoDir := getEcosystemSkillsDir(svc, node); ecoDir != "" {
-			addSkillSources(ecoDir, SourceTypeEcosystem, sources)
+			addSkillSources(svc, ecoDir, SourceTypeEcosystem, sources)
 		}
 	}
 
-	if node != nil {
+	if node != nil && !opts.excludes(svc, "project") {
 		if projDir := getProjectSkillsDir(svc, node); projDir != "" {
-			addSkillSources(projDir, SourceTypeProject, sources)
+			addSkillSources(svc, projDir, SourceTypeProject, sources)
 		}
 	}
 
 	// Playbook-owned skills: walk playbooks/<name>/skills for each playbook
 	// bundle in the workspace's playbooks directory. These skills sync
 	// identically to standalone skills.
-	addPlaybookSkillSources(svc, node, sources)
+	if !opts.excludes(svc, "playbook") {
+		addPlaybookSkillSources(svc, node, sources)
+	}
+
+	if !opts.IncludeDisabled {
+		removeDisabledSkillSources(sources)
+	}
+
+	if scope := nodeSkillScope(node); scope != nil {
+		for name := range sources {
+			if !scope.Allows(name) {
+				delete(sources, name)
+			}
+		}
+	}
 
 	return sources
 }
 
+// removeDisabledSkillSources drops skills whose winning source is marked
+// `disabled: true`. Disabling the highest-precedence copy hides the skill
+// entirely rather than falling back to a lower-precedence copy.
+func removeDisabledSkillSources(sources map[string]SkillSource) {
+	for name, src := range sources {
+		if meta, err := ReadSkillMetadata(src); err == nil && meta.Disabled {
+			delete(sources, name)
+		}
+	}
+}
+
 // addPlaybookSkillSources discovers skills shipped inside playbook bundles
 // and registers them as standard skill sources. It walks the full 4-tier
 // playbook search path (project > ecosystem > user > builtin) so sync
@@ -261,7 +444,7 @@ func addPlaybookSkillSources(svc *service.Service, node *workspace.WorkspaceNode
 			// than using addSkillSourceSafely (which picks shallowest
 			// path and keeps the first-seen entry when types match).
 			tierSources := make(map[string]SkillSource)
-			addSkillSources(pbSkills, SourceTypeProject, tierSources)
+			addSkillSources(svc, pbSkills, SourceTypeProject, tierSources)
 			for name, src := range tierSources {
 				sources[name] = src
 			}
@@ -276,12 +459,30 @@ func addPlaybookSkillSources(svc *service.Service, node *workspace.WorkspaceNode
 
 // addNotebookSkillSources scans all configured notebook definitions for skill directories.
 func addNotebookSkillSources(svc *service.Service, sources map[string]SkillSource) {
+	for _, skillsDir := range notebookSkillDirs(svc) {
+		addSkillSources(svc, skillsDir, SourceTypeEcosystem, sources)
+	}
+}
+
+// notebookSkillDirs returns the skills directory of every workspace in every
+// configured notebook definition (or only the --notebook one).
+func notebookSkillDirs(svc *service.Service) []string {
 	if svc == nil || svc.Config == nil || svc.Config.Notebooks == nil {
-		return
+		return nil
 	}
 
-	for _, nb := range svc.Config.Notebooks.Definitions {
-		if nb == nil || nb.RootDir == "" {
+	// Walk notebooks in name order so that when two define the same skill at
+	// the same depth, the winner doesn't depend on map iteration order.
+	names := make([]string, 0, len(svc.Config.Notebooks.Definitions))
+	for name := range svc.Config.Notebooks.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var dirs []string
+	for _, name := range names {
+		nb := svc.Config.Notebooks.Definitions[name]
+		if nb == nil || nb.RootDir == "" || (notebookOverride(svc) != "" && name != notebookOverride(svc)) {
 			continue
 		}
 
@@ -297,13 +498,13 @@ func addNotebookSkillSources(svc *service.Service, sources map[string]SkillSourc
 		}
 
 		for _, wsEntry := range wsEntries {
-			if !wsEntry.IsDir() {
+			if !wsEntry.IsDir() && (!followSymlinks(svc) || wsEntry.Type()&fs.ModeSymlink == 0) {
 				continue
 			}
-			skillsDir := filepath.Join(workspacesDir, wsEntry.Name(), "skills")
-			addSkillSources(skillsDir, SourceTypeEcosystem, sources)
+			dirs = append(dirs, filepath.Join(workspacesDir, wsEntry.Name(), "skills"))
 		}
 	}
+	return dirs
 }
 
 // getEcosystemSkillsDir returns the skills directory for the ecosystem containing the node
@@ -315,7 +516,7 @@ func getEcosystemSkillsDir(svc *service.Service, node *workspace.WorkspaceNode)
 	ecoNode := &workspace.WorkspaceNode{
 		Name:         filepath.Base(node.RootEcosystemPath),
 		Path:         node.RootEcosystemPath,
-		NotebookName: node.NotebookName,
+		NotebookName: notebookFor(svc, node),
 	}
 
 	skillsDir, err := svc.NotebookLocator.GetSkillsDir(ecoNode)
@@ -334,7 +535,7 @@ func getProjectSkillsDir(svc *service.Service, node *workspace.WorkspaceNode) st
 		return ""
 	}
 
-	skillsDir, err := svc.NotebookLocator.GetSkillsDir(node)
+	skillsDir, err := svc.NotebookLocator.GetSkillsDir(withNotebook(svc, node))
 	if err != nil {
 		return ""
 	}
@@ -344,16 +545,31 @@ func getProjectSkillsDir(svc *service.Service, node *workspace.WorkspaceNode) st
 	return skillsDir
 }
 
+// providerAliases maps short provider names to their canonical form.
+var providerAliases = map[string]string{
+	"cc": "claude",
+	"cx": "codex",
+	"oc": "opencode",
+}
+
+// NormalizeProvider lowercases a provider name and expands short aliases
+// (cc, cx, oc) to the canonical provider. Unknown names are returned lowercased.
+func NormalizeProvider(provider string) string {
+	p := strings.ToLower(strings.TrimSpace(provider))
+	if canonical, ok := providerAliases[p]; ok {
+		return canonical
+	}
+	return p
+}
+
 // GetSkillsDirectoryForWorktree returns the standard skills directory path for a worktree.
+// Unknown providers fall back to the claude layout.
 func GetSkillsDirectoryForWorktree(worktreePath, provider string) string {
-	switch provider {
-	case "codex":
-		return filepath.Join(worktreePath, ".codex", "skills")
-	case "opencode":
-		return filepath.Join(worktreePath, ".opencode", "skill")
-	default:
-		return filepath.Join(worktreePath, ".claude", "skills")
+	if dir, err := ProviderSkillsDir(worktreePath, provider, "project"); err == nil {
+		return dir
 	}
+	dir, _ := ProviderSkillsDir(worktreePath, "claude", "project")
+	return dir
 }
 
 // NewServiceForNode creates a minimal service for skill operations on a specific node.
@@ -371,20 +587,198 @@ func NewServiceForNode(node *workspace.WorkspaceNode) (*service.Service, error)
 	}, nil
 }
 
+// Permissions of a read-only install (sync --read-only): write bits cleared so
+// installed skills aren't edited locally. Sync itself can still replace and
+// prune such installs (see RemoveSkillDir).
+const (
+	ReadOnlyDirMode  os.FileMode = 0o555
+	ReadOnlyFileMode os.FileMode = 0o444
+)
+
 // SyncOptions configures the behavior of a workspace skill synchronization.
 type SyncOptions struct {
 	Prune  bool
 	DryRun bool
+
+	// Diff, together with DryRun, fills SyncResult.Diffs with the content
+	// changes each skill would receive. It has no effect on a real sync.
+	Diff bool
+
+	// Merge overwrites only the files shipped by each skill instead of wiping
+	// the destination skill directory first, preserving user-added sidecar files.
+	Merge bool
+
+	// IncludeDisabled syncs skills marked `disabled: true` instead of skipping them.
+	IncludeDisabled bool
+
+	// Since, when set to a git ref, limits writes to skills whose source
+	// directory changed between that ref and HEAD. Unchanged skills still
+	// count as configured, so --prune never removes them.
+	Since string
+
+	// ExcludeSources drops whole discovery tiers (see SourceTiers) before
+	// resolving, e.g. to ignore inherited ecosystem skills for one sync.
+	ExcludeSources []string
+
+	// WarnShadowed reports configured skills whose winning source hides a
+	// lower-precedence copy with different content (see SyncResult.Shadowed).
+	WarnShadowed bool
+
+	// DirMode and FileMode, when non-zero, override the default 0755/0644
+	// permissions of installed skill directories and files. ReadOnlyDirMode
+	// and ReadOnlyFileMode produce installs that can only change via re-sync.
+	DirMode  os.FileMode
+	FileMode os.FileMode
+
+	// Hardlink links installed files to their on-disk source instead of
+	// copying them, falling back to a copy when linking fails (e.g. across
+	// filesystems). Builtin and stripped files are always written as copies.
+	// Because linked files share the source inode, FileMode would also change
+	// the source's permissions; callers should not combine the two.
+	Hardlink bool
+
+	// LinkSource installs each on-disk skill as a symlink to its resolved
+	// source directory, so edits to the source show up in every workspace
+	// without re-syncing. Builtin skills have no directory to link and are
+	// copied. Content transforms and permission overrides would modify the
+	// source through the link, so callers should not combine them with it.
+	LinkSource bool
+
+	// Exact compares installed and source files byte for byte when deciding
+	// whether a skill changed. By default line endings and trailing whitespace
+	// are normalized first (see contentEqual), so cosmetic differences don't
+	// trigger rewrites or show up as drift.
+	Exact bool
+
+	// SelfCheck re-reads every installed SKILL.md after writing and runs
+	// ValidateSkillContent on it, reporting failures in the verify phase.
+	SelfCheck bool
+
+	// Render appends the files listed in each skill's `includes` frontmatter
+	// to the installed SKILL.md (see RenderSkillIncludes). The source files
+	// themselves are still installed alongside it.
+	Render bool
+
+	// StripComments removes HTML comments and collapses blank lines in each
+	// installed SKILL.md body (see StripSkillContent). Off by default because
+	// it changes the content agents read.
+	StripComments bool
+
+	// Canonicalize rewrites each installed SKILL.md frontmatter in canonical
+	// form (see CanonicalizeSkillContent), leaving the body untouched. Off by
+	// default because it changes the installed content.
+	Canonicalize bool
+
+	// PrunePaths are additional skills directories (e.g. a stale user-scope
+	// ~/.claude/skills) from which skills not configured for this workspace
+	// are removed, independently of Prune. DryRun only lists them.
+	PrunePaths []string
+
+	// AllowEmptyPrune lets Prune and PrunePaths remove every installed skill
+	// when the workspace keeps none. Without it such a prune is skipped and
+	// reported in SyncResult.PruneSkipped, so a broken config or failed source
+	// scan can't wipe installed skills.
+	AllowEmptyPrune bool
+
+	// NoCreateBase refuses to create a provider's skills directory (e.g.
+	// .claude/skills) that doesn't exist yet, failing those skills instead,
+	// so sync never sets up a provider in a directory where it isn't in use.
+	NoCreateBase bool
+
+	// Atomic rebuilds each provider skills directory in a staging directory
+	// and swaps it into place, so agents reading it concurrently never see a
+	// half-synced set. A directory is left unchanged if any skill fails.
+	Atomic bool
+
+	// ProviderMap maps workspace names to the provider their skills are
+	// synced to, overriding [skills] providers and per-dependency providers
+	// for that workspace. It lets a multi-workspace sync send each project to
+	// its own agent.
+	ProviderMap map[string]string
+
+	// DetectRenames matches installed skills that are no longer configured to
+	// configured skills that aren't installed by SKILL.md content, treating
+	// them as a renamed source. Matches are moved to their new name before
+	// syncing instead of leaving the old copy behind (see SyncResult.Renamed).
+	DetectRenames bool
 }
 
 // SyncResult holds the results of a SyncWorkspace operation.
 type SyncResult struct {
-	Workspace    string
+	Workspace string
+	// SyncedSkills lists the skills the sync wrote, or on a dry run those
+	// that would be written. A skill with a failed or aborted write is listed
+	// in Errors instead.
 	SyncedSkills []string
 	DestPaths    []string
 	Error        string
+
+	// UpToDate lists configured skills whose every install already matched
+	// the source, so nothing was (or, on a dry run, would be) written.
+	UpToDate []string
+
+	// PrunedPaths lists skill directories removed by --prune. On a dry run
+	// it lists the directories that would be removed.
+	PrunedPaths []string
+
+	// PruneSkipped is set, to the reason, when a prune was refused because it
+	// would have removed every installed skill (see SyncOptions.AllowEmptyPrune).
+	PruneSkipped string
+
+	// SkippedSkills lists configured skills that were left untouched
+	// (e.g. unchanged since the --since ref).
+	SkippedSkills []string
+
+	// Errors lists every per-skill failure, in the order encountered.
+	Errors []SyncError
+
+	// Duplicates lists configured skills defined more than once within the
+	// tier they resolved from (see FindDuplicateSkills).
+	Duplicates []DuplicateSkill
+
+	// Deprecated maps synced skills that are marked deprecated to their
+	// deprecation message.
+	Deprecated map[string]string
+
+	// Shadowed lists lower-precedence copies that differ from the synced
+	// copy. Only populated when SyncOptions.WarnShadowed is set.
+	Shadowed []ShadowedSkill
+
+	// Diffs holds the pending content change per installed skill. Only
+	// populated on a dry run with SyncOptions.Diff set.
+	Diffs []SkillDiff
+
+	// PlannedInstalls lists, on a dry run, every skill directory in the
+	// workspace and its worktrees that sync would write, i.e. those not
+	// already up to date.
+	PlannedInstalls []string
+
+	// Renamed lists installs moved to a renamed skill's new name, or on a
+	// dry run those that would be. Only populated with
+	// SyncOptions.DetectRenames set.
+	Renamed []SkillRename
 }
 
+// Sync phases reported in SyncError.Phase.
+const (
+	SyncPhaseResolve = "resolve"
+	SyncPhaseWrite   = "write"
+	SyncPhasePrune   = "prune"
+	SyncPhaseVerify  = "verify"
+)
+
+// SyncError describes a single failure during sync: which skill, in which
+// phase, and why.
+type SyncError struct {
+	Skill string
+	Phase string
+	Err   error
+}
+
+func (e SyncError) Error() string { return e.Err.Error() }
+
+func (e SyncError) Unwrap() error { return e.Err }
+
 // SyncWorkspace resolves and installs skills for a single workspace node.
 func SyncWorkspace(svc *service.Service, node *workspace.WorkspaceNode, opts SyncOptions, logger *logging.PrettyLogger) (*SyncResult, error) {
 	result := &SyncResult{
@@ -403,7 +797,7 @@ func SyncWorkspace(svc *service.Service, node *workspace.WorkspaceNode, opts Syn
 		gitRoot = node.Path
 	}
 
-	skillsCfg, err := LoadSkillsConfig(svc.Config, node)
+	skillsCfg, err := loadDesiredSkillsConfig(svc.Config, node)
 	if err != nil {
 		return result, fmt.Errorf("failed to load skills config: %w", err)
 	}
@@ -416,7 +810,10 @@ func SyncWorkspace(svc *service.Service, node *workspace.WorkspaceNode, opts Syn
 	}
 
 	providers := []string{"claude"}
-	if len(skillsCfg.Providers) > 0 {
+	override, hasOverride := opts.ProviderMap[node.Name]
+	if hasOverride {
+		providers = []string{NormalizeProvider(override)}
+	} else if len(skillsCfg.Providers) > 0 {
 		providers = skillsCfg.Providers
 	}
 
@@ -428,34 +825,57 @@ func SyncWorkspace(svc *service.Service, node *workspace.WorkspaceNode, opts Syn
 	}
 
 	if len(skillsCfg.Use) == 0 && len(skillsCfg.Dependencies) == 0 && !hasPlaybookSkills {
-		if opts.Prune && !opts.DryRun {
-			for _, provider := range providers {
-				destBaseDir := GetSkillsDirectoryForWorktree(gitRoot, provider)
-				cleanupRemovedSkills(destBaseDir, nil)
-			}
-		}
+		pruneToEmpty(result, gitRoot, providers, opts, "no skills are configured")
 		return result, nil
 	}
 
-	resolved, err := ResolveConfiguredSkills(svc, node, skillsCfg)
+	resolved, err := ResolveConfiguredSkillsWithOptions(svc, node, skillsCfg, DiscoveryOptions{
+		IncludeDisabled: opts.IncludeDisabled,
+		ExcludeSources:  opts.ExcludeSources,
+	})
 	if err != nil {
 		return result, fmt.Errorf("failed to resolve skills: %w", err)
 	}
 
 	if len(resolved) == 0 {
-		if opts.Prune && !opts.DryRun {
-			for _, provider := range providers {
-				destBaseDir := GetSkillsDirectoryForWorktree(gitRoot, provider)
-				cleanupRemovedSkills(destBaseDir, nil)
-			}
-		}
+		pruneToEmpty(result, gitRoot, providers, opts, "the configured skills resolved to none")
 		return result, nil
 	}
+	if hasOverride {
+		for name, r := range resolved {
+			r.Providers = providers
+			resolved[name] = r
+		}
+	}
+
+	configured := make(map[string]bool, len(resolved))
+	for name := range resolved {
+		configured[name] = true
+	}
+
+	var only map[string]bool
+	if opts.Since != "" {
+		only, err = skillsChangedSince(svc.Context(), resolved, opts.Since)
+		if err != nil {
+			return result, err
+		}
+	}
 
-	synced := make([]string, 0, len(resolved))
+	selected := make([]string, 0, len(resolved))
 	destPathsMap := make(map[string]bool)
 	for name, r := range resolved {
-		synced = append(synced, name)
+		if only != nil && !only[name] {
+			result.SkippedSkills = append(result.SkippedSkills, name)
+			continue
+		}
+		selected = append(selected, name)
+		src := SkillSource{Path: r.PhysicalPath, RelPath: r.RelPath, Type: r.SourceType}
+		if meta, err := ReadSkillMetadata(src); err == nil && meta.Deprecated != "" {
+			if result.Deprecated == nil {
+				result.Deprecated = make(map[string]string)
+			}
+			result.Deprecated[name] = meta.Deprecated
+		}
 		for _, p := range r.Providers {
 			destPathsMap[GetSkillsDirectoryForWorktree(gitRoot, p)] = true
 		}
@@ -466,45 +886,305 @@ func SyncWorkspace(svc *service.Service, node *workspace.WorkspaceNode, opts Syn
 		destPaths = append(destPaths, p)
 	}
 
-	result.SyncedSkills = synced
 	result.DestPaths = destPaths
 
+	if opts.WarnShadowed {
+		result.Shadowed = findShadowedSkills(svc, node, resolved, DiscoveryOptions{
+			IncludeDisabled: opts.IncludeDisabled,
+			ExcludeSources:  opts.ExcludeSources,
+		})
+	}
+	for _, dup := range FindDuplicateSkills(svc, node) {
+		if _, ok := resolved[dup.Name]; ok {
+			result.Duplicates = append(result.Duplicates, dup)
+		}
+	}
+
+	var renameErrs []SyncError
+	if opts.DetectRenames {
+		result.Renamed = detectRenames(gitRoot, resolved, opts)
+		if !opts.DryRun {
+			renameErrs = migrateRenames(result.Renamed, logger)
+		}
+	}
+
+	sort.Strings(selected)
+	planned := planInstalls(gitRoot, resolved, only, opts)
+	result.SyncedSkills, result.UpToDate = splitPlannedSkills(selected, planned)
+
 	if opts.DryRun {
+		result.PlannedInstalls = planned
+		if opts.Diff {
+			result.Diffs = diffConfiguredSkills(gitRoot, resolved, only, opts)
+		}
+		if opts.Prune {
+			result.PrunedPaths = planPrunes(gitRoot, resolved)
+			for _, r := range result.Renamed {
+				result.PrunedPaths = slices.DeleteFunc(result.PrunedPaths, func(p string) bool { return p == r.Path })
+			}
+		}
+		result.PrunedPaths = append(result.PrunedPaths, pruneExtraPaths(opts.PrunePaths, configured, true)...)
 		return result, nil
 	}
 
-	_, err = SyncConfiguredSkills(gitRoot, resolved, opts.Prune, logger)
-	return result, err
+	_, pruned, errs := syncConfiguredSkills(svc.Context(), gitRoot, resolved, only, opts, logger)
+	result.SyncedSkills = withoutFailedWrites(result.SyncedSkills, errs)
+	errs = append(renameErrs, errs...)
+	pruned = append(pruned, pruneExtraPaths(opts.PrunePaths, configured, false)...)
+	if opts.SelfCheck {
+		errs = append(errs, verifyInstalledSkills(gitRoot, resolved)...)
+	}
+	result.PrunedPaths = append(result.PrunedPaths, pruned...)
+	result.Errors = errs
+	recordSyncHistory(result.Workspace, gitRoot, resolved, result.SyncedSkills, pruned, errs)
+	return result, lastSyncError(errs)
+}
+
+// withoutFailedWrites drops the skills with a write error in errs from names.
+func withoutFailedWrites(names []string, errs []SyncError) []string {
+	failed := make(map[string]bool)
+	for _, e := range errs {
+		if e.Phase == SyncPhaseWrite {
+			failed[e.Skill] = true
+		}
+	}
+	return slices.DeleteFunc(names, func(name string) bool { return failed[name] })
+}
+
+// pruneToEmpty runs --prune and --prune-path for a workspace that keeps no
+// skills at all. Because that deletes every installed skill, and an empty
+// result is more often a broken grove.toml or source scan than intent, it only
+// proceeds with opts.AllowEmptyPrune; otherwise it records reason in
+// result.PruneSkipped when there was something to prune.
+func pruneToEmpty(result *SyncResult, gitRoot string, providers []string, opts SyncOptions, reason string) {
+	if !opts.AllowEmptyPrune {
+		dirs := append([]string(nil), opts.PrunePaths...)
+		if opts.Prune {
+			for _, provider := range providers {
+				dirs = append(dirs, GetSkillsDirectoryForWorktree(gitRoot, provider))
+			}
+		}
+		for _, dir := range dirs {
+			if len(pruneCandidates(dir, nil)) > 0 {
+				result.PruneSkipped = reason
+				break
+			}
+		}
+		return
+	}
+	if opts.Prune {
+		pruneAllSkills(result, gitRoot, providers, opts.DryRun)
+	}
+	result.PrunedPaths = append(result.PrunedPaths, pruneExtraPaths(opts.PrunePaths, nil, opts.DryRun)...)
+}
+
+// pruneAllSkills removes (or, on a dry run, lists) every installed skill for
+// the given providers. It backs --prune when nothing is configured.
+func pruneAllSkills(result *SyncResult, gitRoot string, providers []string, dryRun bool) {
+	for _, provider := range providers {
+		destBaseDir := GetSkillsDirectoryForWorktree(gitRoot, provider)
+		if dryRun {
+			result.PrunedPaths = append(result.PrunedPaths, pruneCandidates(destBaseDir, nil)...)
+		} else {
+			result.PrunedPaths = append(result.PrunedPaths, cleanupRemovedSkills(destBaseDir, nil)...)
+		}
+	}
+	if !dryRun {
+		recordSyncHistory(result.Workspace, gitRoot, nil, nil, result.PrunedPaths, nil)
+	}
+}
+
+// pruneExtraPaths removes (or, on a dry run, lists) the skill directories in
+// each of dirs whose name is not in keep. A nil keep matches every skill.
+func pruneExtraPaths(dirs []string, keep map[string]bool, dryRun bool) []string {
+	var paths []string
+	for _, dir := range dirs {
+		if dryRun {
+			paths = append(paths, pruneCandidates(dir, keep)...)
+		} else {
+			paths = append(paths, cleanupRemovedSkills(dir, keep)...)
+		}
+	}
+	return paths
+}
+
+// lastSyncError returns the most recent failure, or nil if there were none.
+func lastSyncError(errs []SyncError) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs[len(errs)-1]
 }
 
 // cleanupRemovedSkills removes skill directories that are no longer in the configured set.
-// If configuredSkills is nil, removes ALL skill directories.
-func cleanupRemovedSkills(skillsDir string, configuredSkills map[string]bool) {
+// If configuredSkills is nil, removes ALL skill directories. Returns the removed paths.
+func cleanupRemovedSkills(skillsDir string, configuredSkills map[string]bool) []string {
+	var removed []string
+	for _, path := range pruneCandidates(skillsDir, configuredSkills) {
+		if err := RemoveSkillDir(path); err == nil {
+			removed = append(removed, path)
+		}
+	}
+	return removed
+}
+
+// verifyInstalledSkills validates the installed SKILL.md of every resolved
+// skill under gitRoot and its worktrees, catching writes that landed
+// truncated or otherwise malformed.
+func verifyInstalledSkills(gitRoot string, resolved map[string]ResolvedSkill) []SyncError {
+	roots := syncRoots(gitRoot)
+
+	names := make([]string, 0, len(resolved))
+	for name := range resolved {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []SyncError
+	for _, root := range roots {
+		for _, name := range names {
+			for _, provider := range resolved[name].Providers {
+				skillFile := filepath.Join(GetSkillsDirectoryForWorktree(root, provider), name, "SKILL.md")
+				content, err := os.ReadFile(skillFile) //nolint:gosec // G304: installed skill path
+				if err == nil {
+					err = ValidateSkillContent(content, name)
+				}
+				if err != nil {
+					errs = append(errs, SyncError{Skill: name, Phase: SyncPhaseVerify, Err: fmt.Errorf("installed copy at %s failed self-check: %w", skillFile, err)})
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// syncRoots returns gitRoot followed by every worktree under .grove-worktrees/,
+// i.e. every root a sync installs skills into.
+func syncRoots(gitRoot string) []string {
+	roots := []string{gitRoot}
+	worktreesDir := filepath.Join(gitRoot, ".grove-worktrees")
+	if entries, err := os.ReadDir(worktreesDir); err == nil {
+		for _, entry := range entries {
+			if entry.IsDir() {
+				roots = append(roots, filepath.Join(worktreesDir, entry.Name()))
+			}
+		}
+	}
+	return roots
+}
+
+// pruneCandidates returns the skill directories in skillsDir that are not in
+// keep. A nil keep matches every directory.
+func pruneCandidates(skillsDir string, keep map[string]bool) []string {
 	entries, err := os.ReadDir(skillsDir)
 	if err != nil {
-		return
+		return nil
 	}
 
+	var paths []string
 	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
+		isSkill := entry.IsDir() || entry.Type()&fs.ModeSymlink != 0
+		if isSkill && (keep == nil || !keep[entry.Name()]) {
+			paths = append(paths, filepath.Join(skillsDir, entry.Name()))
+		}
+	}
+	return paths
+}
+
+// planInstalls returns the skill directories a sync of resolved would write
+// under gitRoot and its worktrees, skipping installs that are already up to
+// date, without touching disk.
+func planInstalls(gitRoot string, resolved map[string]ResolvedSkill, only map[string]bool, opts SyncOptions) []string {
+	var paths []string
+	for _, root := range syncRoots(gitRoot) {
+		for name, r := range resolved {
+			if only != nil && !only[name] {
+				continue
+			}
+			for _, provider := range r.Providers {
+				destPath := filepath.Join(GetSkillsDirectoryForWorktree(root, provider), name)
+				if skillNeedsInstall(r, destPath, opts) {
+					paths = append(paths, destPath)
+				}
+			}
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// splitPlannedSkills partitions names into the skills with at least one
+// planned install (see planInstalls) and those already up to date everywhere.
+func splitPlannedSkills(names, planned []string) (changed, upToDate []string) {
+	pending := make(map[string]bool, len(planned))
+	for _, path := range planned {
+		pending[filepath.Base(path)] = true
+	}
+	for _, name := range names {
+		if pending[name] {
+			changed = append(changed, name)
+		} else {
+			upToDate = append(upToDate, name)
+		}
+	}
+	return changed, upToDate
+}
+
+// planPrunes returns the directories a --prune sync of resolved would remove
+// from gitRoot and its worktrees, without touching disk.
+func planPrunes(gitRoot string, resolved map[string]ResolvedSkill) []string {
+	installedPerProvider := make(map[string]map[string]bool)
+	for name, r := range resolved {
+		for _, provider := range r.Providers {
+			if installedPerProvider[provider] == nil {
+				installedPerProvider[provider] = make(map[string]bool)
+			}
+			installedPerProvider[provider][name] = true
 		}
-		if configuredSkills == nil || !configuredSkills[entry.Name()] {
-			_ = os.RemoveAll(filepath.Join(skillsDir, entry.Name()))
+	}
+
+	roots := syncRoots(gitRoot)
+
+	var paths []string
+	for _, root := range roots {
+		for provider, validNames := range installedPerProvider {
+			paths = append(paths, pruneCandidates(GetSkillsDirectoryForWorktree(root, provider), validNames)...)
 		}
 	}
+	sort.Strings(paths)
+	return paths
 }
 
 // SyncConfiguredSkills syncs resolved skills to their target provider directories.
 // Skills are always flattened to a single level: .claude/skills/<skillName>/.
 func SyncConfiguredSkills(gitRoot string, resolved map[string]ResolvedSkill, prune bool, logger *logging.PrettyLogger) (int, error) {
+	count, _, errs := syncConfiguredSkills(context.Background(), gitRoot, resolved, nil, SyncOptions{Prune: prune}, logger)
+	return count, lastSyncError(errs)
+}
+
+// syncConfiguredSkills is SyncConfiguredSkills with the full set of sync options.
+// When only is non-nil, just the named skills are written; every resolved skill
+// is still treated as configured for pruning. Returns the number of skills
+// written, the paths removed by pruning, and every failure encountered.
+// Once ctx is done no further skill is written and nothing is pruned.
+func syncConfiguredSkills(ctx context.Context, gitRoot string, resolved map[string]ResolvedSkill, only map[string]bool, opts SyncOptions, logger *logging.PrettyLogger) (int, []string, []SyncError) {
+	if opts.Atomic {
+		return syncConfiguredSkillsAtomic(ctx, gitRoot, resolved, only, opts, logger)
+	}
 	syncedCount := 0
-	var lastErr error
+	var errs []SyncError
 
 	// Track installed RelPaths per provider for pruning
 	installedPerProvider := make(map[string]map[string]bool)
 
-	for skillName, r := range resolved {
+	names := make([]string, 0, len(resolved))
+	for name := range resolved {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i, skillName := range names {
+		r := resolved[skillName]
 		for _, provider := range r.Providers {
 			destBaseDir := GetSkillsDirectoryForWorktree(gitRoot, provider)
 			destPath := filepath.Join(destBaseDir, skillName)
@@ -514,63 +1194,382 @@ func SyncConfiguredSkills(gitRoot string, resolved map[string]ResolvedSkill, pru
 			}
 			installedPerProvider[provider][skillName] = true
 
-			if err := os.MkdirAll(destBaseDir, 0o755); err != nil { //nolint:gosec // G301: skills dir
-				lastErr = fmt.Errorf("failed to create directory %s: %w", destBaseDir, err)
+			if only != nil && !only[skillName] {
 				continue
 			}
+			if err := ctx.Err(); err != nil {
+				// Report every skill left unwritten, not just this one, so
+				// callers can tell exactly which skills the sync covered.
+				for _, name := range names[i:] {
+					if only == nil || only[name] {
+						errs = append(errs, abortedSyncError(name, err))
+					}
+				}
+				return syncedCount, nil, errs
+			}
 
-			_ = os.RemoveAll(destPath)
+			if err := ensureSkillsBaseDir(destBaseDir, opts); err != nil {
+				errs = append(errs, SyncError{Skill: skillName, Phase: SyncPhaseWrite, Err: err})
+				continue
+			}
 
-			if r.SourceType == SourceTypeBuiltin {
-				files, err := readSkillFromFS(embeddedSkillsFS, r.RelPath)
-				if err != nil {
-					lastErr = err
-					continue
-				}
+			if err := installResolvedSkill(r, destPath, opts); err != nil {
+				errs = append(errs, SyncError{Skill: skillName, Phase: SyncPhaseWrite, Err: err})
+				continue
+			}
+			syncedCount++
+		}
+	}
 
-				if err := os.MkdirAll(destPath, 0o755); err != nil { //nolint:gosec // G301: skills dir
-					lastErr = err
-					continue
-				}
+	var pruned []string
+	if opts.Prune {
+		var pruneErrs []SyncError
+		pruned, pruneErrs = pruneSkillsDir(gitRoot, installedPerProvider, logger)
+		errs = append(errs, pruneErrs...)
+	}
 
-				for relPath, content := range files {
-					filePath := filepath.Join(destPath, relPath)
-					if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil { //nolint:gosec // G301: skill subdir
-						lastErr = err
-						continue
-					}
-					if err := os.WriteFile(filePath, content, 0o644); err != nil { //nolint:gosec // G306: skill files
-						lastErr = err
-						continue
-					}
-				}
-				syncedCount++
-			} else {
-				if err := corefs.CopyDir(r.PhysicalPath, destPath); err != nil {
-					lastErr = fmt.Errorf("failed to copy skill %s: %w", skillName, err)
-				} else {
-					syncedCount++
-				}
+	wtPruned, wtErrs := syncSkillsToWorktrees(ctx, gitRoot, resolved, only, installedPerProvider, opts, logger)
+	pruned = append(pruned, wtPruned...)
+	errs = append(errs, wtErrs...)
+	return syncedCount, pruned, errs
+}
+
+// abortedSyncError records that the sync stopped before writing skill
+// because ctx was done (--timeout).
+func abortedSyncError(skill string, err error) SyncError {
+	return SyncError{Skill: skill, Phase: SyncPhaseWrite, Err: fmt.Errorf("sync aborted: %w", err)}
+}
+
+// ensureSkillsBaseDir creates a provider's skills directory if needed. With
+// opts.NoCreateBase a missing directory is an error instead.
+func ensureSkillsBaseDir(dir string, opts SyncOptions) error {
+	if opts.NoCreateBase {
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			return fmt.Errorf("skills directory %s does not exist; not creating it", dir)
+		}
+		return nil
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gosec // G301: skills dir
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+	return nil
+}
+
+// installResolvedSkill writes a single resolved skill to destPath. By default the
+// destination is wiped first so it mirrors the source exactly; with opts.Merge the
+// skill's files are overwritten in place and any other files already present in
+// the destination are left untouched. If the destination already matches the
+// source nothing is written, so repeated syncs are idempotent.
+func installResolvedSkill(r ResolvedSkill, destPath string, opts SyncOptions) error {
+	if r.SourceType != SourceTypeBuiltin {
+		if err := checkSourceOutsideDest(r.PhysicalPath, destPath); err != nil {
+			return err
+		}
+	}
+	if opts.LinkSource && r.SourceType != SourceTypeBuiltin {
+		return linkResolvedSkill(r, destPath)
+	}
+	if skillNeedsInstall(r, destPath, opts) {
+		if err := writeResolvedSkill(r, destPath, opts); err != nil {
+			return err
+		}
+		if err := writeTransformedSkillMD(r, destPath, opts); err != nil {
+			return err
+		}
+	}
+	// Modes are applied even when content is unchanged so a new permission
+	// policy takes effect without rewriting files.
+	return applySkillModes(destPath, opts)
+}
+
+// skillNeedsInstall reports whether installResolvedSkill would write destPath:
+// with opts.LinkSource, unless it already links to the source; otherwise
+// unless it holds an up-to-date copy. A linked install reads back as identical
+// to its source, so it needs replacing with a real copy once linking is no
+// longer requested.
+func skillNeedsInstall(r ResolvedSkill, destPath string, opts SyncOptions) bool {
+	if opts.LinkSource && r.SourceType != SourceTypeBuiltin {
+		src, err := filepath.Abs(r.PhysicalPath)
+		if err != nil {
+			return true
+		}
+		target, err := os.Readlink(destPath)
+		return err != nil || target != src
+	}
+	return isSymlink(destPath) || !skillUpToDate(r, destPath, opts)
+}
+
+// linkResolvedSkill replaces destPath with a symlink to the skill's absolute
+// source directory. An existing link to the same source is left alone.
+func linkResolvedSkill(r ResolvedSkill, destPath string) error {
+	src, err := filepath.Abs(r.PhysicalPath)
+	if err != nil {
+		return err
+	}
+	if target, err := os.Readlink(destPath); err == nil && target == src {
+		return nil
+	}
+	if err := RemoveSkillDir(destPath); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(destPath), 0o755); err != nil { //nolint:gosec // G301: skills dir
+		return err
+	}
+	if err := os.Symlink(src, destPath); err != nil {
+		return fmt.Errorf("failed to link skill %s: %w", r.Name, err)
+	}
+	return nil
+}
+
+// isSymlink reports whether path itself is a symbolic link.
+func isSymlink(path string) bool {
+	info, err := os.Lstat(path)
+	return err == nil && info.Mode()&fs.ModeSymlink != 0
+}
+
+// checkSourceOutsideDest refuses to install a skill whose source directory lies
+// inside the destination's skills directory, or contains the destination. In
+// both cases wiping and re-copying the destination would delete or recursively
+// copy the source itself.
+func checkSourceOutsideDest(srcPath, destPath string) error {
+	// Resolve only the parent of destPath: the destination itself may be a
+	// --link-source symlink pointing at the source.
+	destBase := canonicalPath(filepath.Dir(destPath))
+	src, dest := canonicalPath(srcPath), filepath.Join(destBase, filepath.Base(destPath))
+	if pathWithin(src, destBase) || pathWithin(dest, src) {
+		return fmt.Errorf("refusing to install skill from %s into %s: source and destination overlap", srcPath, destPath)
+	}
+	return nil
+}
+
+// canonicalPath returns an absolute, symlink-resolved form of p. Components
+// that don't exist yet are kept as-is below the deepest existing parent.
+func canonicalPath(p string) string {
+	abs, err := filepath.Abs(p)
+	if err != nil {
+		return filepath.Clean(p)
+	}
+	if resolved, err := filepath.EvalSymlinks(abs); err == nil {
+		return resolved
+	}
+	parent := filepath.Dir(abs)
+	if parent == abs {
+		return abs
+	}
+	return filepath.Join(canonicalPath(parent), filepath.Base(abs))
+}
+
+// pathWithin reports whether p equals dir or is nested under it.
+func pathWithin(p, dir string) bool {
+	rel, err := filepath.Rel(dir, p)
+	if err != nil {
+		return false
+	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
+
+// applySkillModes chmods every directory and file under destPath to
+// opts.DirMode / opts.FileMode. Zero modes leave permissions untouched.
+func applySkillModes(destPath string, opts SyncOptions) error {
+	if opts.DirMode == 0 && opts.FileMode == 0 {
+		return nil
+	}
+	return filepath.WalkDir(destPath, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		mode := opts.FileMode
+		if d.IsDir() {
+			mode = opts.DirMode
+		}
+		if mode == 0 {
+			return nil
+		}
+		return os.Chmod(path, mode)
+	})
+}
+
+// RemoveSkillDir removes an installed skill directory (or link) at path. A
+// read-only install's directories are made writable first, since their
+// entries can't be unlinked otherwise.
+func RemoveSkillDir(path string) error {
+	makeDirsWritable(path)
+	return os.RemoveAll(path)
+}
+
+// makeDirsWritable adds owner write permission to every directory under path
+// that lacks it. Symlinks, including path itself, are never followed, so a
+// linked install's source is left alone.
+func makeDirsWritable(path string) {
+	_ = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return nil
+		}
+		if info, err := d.Info(); err == nil && info.Mode().Perm()&0o200 == 0 {
+			_ = os.Chmod(p, info.Mode().Perm()|0o700)
+		}
+		return nil
+	})
+}
+
+// writeResolvedSkill copies the resolved skill's files into destPath.
+func writeResolvedSkill(r ResolvedSkill, destPath string, opts SyncOptions) error {
+	// Never write through a linked install: that would overwrite the source.
+	if !opts.Merge || isSymlink(destPath) {
+		_ = RemoveSkillDir(destPath)
+	} else {
+		makeDirsWritable(destPath)
+	}
+
+	if r.SourceType != SourceTypeBuiltin {
+		if err := placeSkillFiles(r.PhysicalPath, destPath, opts.Hardlink); err != nil {
+			return fmt.Errorf("failed to copy skill %s: %w", r.Name, err)
+		}
+		return nil
+	}
+
+	files, err := readSkillFromFS(embeddedSkillsFS, r.RelPath)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(destPath, 0o755); err != nil { //nolint:gosec // G301: skills dir
+		return err
+	}
+
+	for relPath, content := range files {
+		filePath := filepath.Join(destPath, relPath)
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil { //nolint:gosec // G301: skill subdir
+			return err
+		}
+		// Unlink first: the existing file may be a hardlink to a source.
+		_ = os.Remove(filePath)
+		if err := os.WriteFile(filePath, content, 0o644); err != nil { //nolint:gosec // G306: skill files
+			return err
+		}
+	}
+	return nil
+}
+
+// placeSkillFiles copies (or, with link, hardlinks) every file under src into
+// dst. Existing destination files are unlinked before being replaced so a
+// previous hardlink never causes the source to be overwritten in place.
+func placeSkillFiles(src, dst string, link bool) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, relPath)
+
+		if d.IsDir() {
+			info, err := d.Info()
+			if err != nil {
+				return err
 			}
+			return os.MkdirAll(target, info.Mode().Perm())
 		}
+
+		_ = os.Remove(target)
+		// Linking fails across filesystems (EXDEV) and on filesystems
+		// without hardlink support; copying is always a safe fallback.
+		if link && os.Link(path, target) == nil {
+			return nil
+		}
+		return corefs.CopyFile(path, target)
+	})
+}
+
+// writeTransformedSkillMD replaces the installed SKILL.md with the source
+// SKILL.md after the content transforms in opts (--render, --strip-comments).
+// It does nothing when no transform is enabled.
+func writeTransformedSkillMD(r ResolvedSkill, destPath string, opts SyncOptions) error {
+	files, err := readSkillSourceFiles(SkillSource{Path: r.PhysicalPath, RelPath: r.RelPath, Type: r.SourceType})
+	if err != nil {
+		return err
+	}
+	changed, err := transformSkillFiles(files, opts)
+	if err != nil || !changed {
+		return err
 	}
+	// Never rewrite a hardlinked SKILL.md in place; that would change the source.
+	skillFile := filepath.Join(destPath, "SKILL.md")
+	if err := os.Remove(skillFile); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return os.WriteFile(skillFile, files["SKILL.md"], 0o644) //nolint:gosec // G306: skill files
+}
 
-	if prune {
-		pruneSkillsDir(gitRoot, installedPerProvider, logger)
+// skillUpToDate reports whether destPath already holds the resolved skill's files
+// with identical content (after any content transform in opts). Unless
+// opts.Merge is set, extra files in the destination also count as a difference.
+// Untransformed files of a non-builtin skill must also be hardlinked to their
+// source exactly when opts.Hardlink is set, so switching --hardlink on or off
+// re-places them. (Copies left by the cross-filesystem fallback therefore
+// never count as up to date under --hardlink.)
+func skillUpToDate(r ResolvedSkill, destPath string, opts SyncOptions) bool {
+	src, err := readSkillSourceFiles(SkillSource{Path: r.PhysicalPath, RelPath: r.RelPath, Type: r.SourceType})
+	if err != nil {
+		return false
+	}
+	transformed, err := transformSkillFiles(src, opts)
+	if err != nil {
+		return false
 	}
 
-	syncSkillsToWorktrees(gitRoot, resolved, installedPerProvider, prune, logger)
-	return syncedCount, lastErr
+	dest, err := readSkillFromDisk(destPath)
+	if err != nil {
+		return false
+	}
+	if !opts.Merge && len(dest) != len(src) {
+		return false
+	}
+	for relPath, content := range src {
+		existing, ok := dest[relPath]
+		if !ok || !contentEqual(existing, content, opts.Exact) {
+			return false
+		}
+		if r.SourceType == SourceTypeBuiltin || (transformed && relPath == "SKILL.md") {
+			continue
+		}
+		rel := filepath.FromSlash(relPath)
+		if sameFile(filepath.Join(r.PhysicalPath, rel), filepath.Join(destPath, rel)) != opts.Hardlink {
+			return false
+		}
+	}
+	return true
+}
+
+// sameFile reports whether a and b are the same file, i.e. hardlinks to one
+// inode. It is false if either can't be stat'ed.
+func sameFile(a, b string) bool {
+	ai, err := os.Stat(a)
+	if err != nil {
+		return false
+	}
+	bi, err := os.Stat(b)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(ai, bi)
 }
 
 // syncSkillsToWorktrees syncs resolved skills to all worktrees under .grove-worktrees/.
-func syncSkillsToWorktrees(gitRoot string, resolved map[string]ResolvedSkill, installedPerProvider map[string]map[string]bool, prune bool, logger *logging.PrettyLogger) {
+// Returns the paths removed by pruning and any failures; it stops at the first
+// worktree or skill reached after ctx is done.
+func syncSkillsToWorktrees(ctx context.Context, gitRoot string, resolved map[string]ResolvedSkill, only map[string]bool, installedPerProvider map[string]map[string]bool, opts SyncOptions, logger *logging.PrettyLogger) ([]string, []SyncError) {
 	worktreesDir := filepath.Join(gitRoot, ".grove-worktrees")
 	entries, err := os.ReadDir(worktreesDir)
 	if err != nil {
-		return
+		return nil, nil
 	}
 
+	var pruned []string
+	var errs []SyncError
+
 	for _, entry := range entries {
 		if !entry.IsDir() {
 			continue
@@ -578,63 +1577,53 @@ func syncSkillsToWorktrees(gitRoot string, resolved map[string]ResolvedSkill, in
 		wtPath := filepath.Join(worktreesDir, entry.Name())
 
 		for skillName, r := range resolved {
+			if only != nil && !only[skillName] {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return pruned, append(errs, abortedSyncError(skillName, err))
+			}
 			for _, provider := range r.Providers {
 				destBaseDir := GetSkillsDirectoryForWorktree(wtPath, provider)
 				destPath := filepath.Join(destBaseDir, skillName)
 
-				if err := os.MkdirAll(destBaseDir, 0o755); err != nil { //nolint:gosec // G301: skills dir
+				if err := ensureSkillsBaseDir(destBaseDir, opts); err != nil {
+					errs = append(errs, SyncError{Skill: skillName, Phase: SyncPhaseWrite, Err: err})
 					continue
 				}
 
-				_ = os.RemoveAll(destPath)
-
-				if r.SourceType == SourceTypeBuiltin {
-					files, err := readSkillFromFS(embeddedSkillsFS, r.RelPath)
-					if err != nil {
-						continue
-					}
-					if err := os.MkdirAll(destPath, 0o755); err != nil { //nolint:gosec // G301
-						continue
-					}
-					for relPath, content := range files {
-						filePath := filepath.Join(destPath, relPath)
-						_ = os.MkdirAll(filepath.Dir(filePath), 0o755) //nolint:gosec // G301
-						_ = os.WriteFile(filePath, content, 0o644)     //nolint:gosec // G306
-					}
-				} else {
-					_ = corefs.CopyDir(r.PhysicalPath, destPath)
+				if err := installResolvedSkill(r, destPath, opts); err != nil {
+					errs = append(errs, SyncError{Skill: skillName, Phase: SyncPhaseWrite, Err: err})
 				}
 			}
 		}
 
-		if prune {
-			pruneSkillsDir(wtPath, installedPerProvider, logger)
+		if opts.Prune {
+			wtPruned, pruneErrs := pruneSkillsDir(wtPath, installedPerProvider, logger)
+			pruned = append(pruned, wtPruned...)
+			errs = append(errs, pruneErrs...)
 		}
 	}
+	return pruned, errs
 }
 
 // pruneSkillsDir removes skills not in the installed map from a directory.
 // Skills are always one level deep (flat structure) under the provider skills dir.
-func pruneSkillsDir(root string, installedPerProvider map[string]map[string]bool, logger *logging.PrettyLogger) {
+// Returns the removed paths and any removals that failed.
+func pruneSkillsDir(root string, installedPerProvider map[string]map[string]bool, logger *logging.PrettyLogger) ([]string, []SyncError) {
+	var removed []string
+	var errs []SyncError
 	for provider, validNames := range installedPerProvider {
-		destBaseDir := GetSkillsDirectoryForWorktree(root, provider)
-
-		entries, err := os.ReadDir(destBaseDir)
-		if err != nil {
-			continue
-		}
-
-		for _, entry := range entries {
-			if !entry.IsDir() {
+		for _, path := range pruneCandidates(GetSkillsDirectoryForWorktree(root, provider), validNames) {
+			if err := RemoveSkillDir(path); err != nil {
+				errs = append(errs, SyncError{Skill: filepath.Base(path), Phase: SyncPhasePrune, Err: fmt.Errorf("failed to prune %s: %w", path, err)})
 				continue
 			}
-			if !validNames[entry.Name()] {
-				path := filepath.Join(destBaseDir, entry.Name())
-				_ = os.RemoveAll(path)
-				if logger != nil {
-					logger.InfoPretty(fmt.Sprintf("Pruned unconfigured skill at: %s", path))
-				}
+			removed = append(removed, path)
+			if logger != nil {
+				logger.InfoPretty(fmt.Sprintf("Pruned unconfigured skill at: %s", path))
 			}
 		}
 	}
+	return removed, errs
 }
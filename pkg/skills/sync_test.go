@@ -0,0 +1,819 @@
+package skills
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grovetools/core/config"
+	"github.com/grovetools/core/pkg/workspace"
+	"github.com/grovetools/skills/pkg/service"
+)
+
+// writeUserSkill creates a skill under an isolated XDG user skills directory.
+func writeUserSkill(t *testing.T, configHome, name, frontmatterExtra string) string {
+	t.Helper()
+	dir := filepath.Join(configHome, "grove", "skills", name)
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gosec // G301: test
+		t.Fatal(err)
+	}
+	content := "---\nname: " + name + "\ndescription: Test skill " + name + "\n" + frontmatterExtra + "---\n\nBody.\n"
+	if err := os.WriteFile(filepath.Join(dir, "SKILL.md"), []byte(content), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestListSkillSources_HidesDisabledSkills(t *testing.T) {
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+
+	writeUserSkill(t, configHome, "enabled-skill", "")
+	writeUserSkill(t, configHome, "hidden-skill", "disabled: true\n")
+
+	sources := ListSkillSources(nil, nil)
+	if _, ok := sources["enabled-skill"]; !ok {
+		t.Error("expected enabled-skill to be listed")
+	}
+	if _, ok := sources["hidden-skill"]; ok {
+		t.Error("expected hidden-skill to be excluded by default")
+	}
+
+	sources = ListSkillSourcesWithOptions(nil, nil, DiscoveryOptions{IncludeDisabled: true})
+	if src, ok := sources["hidden-skill"]; !ok || src.Type != SourceTypeUser {
+		t.Errorf("expected hidden-skill from user source with IncludeDisabled, got %+v (found=%v)", src, ok)
+	}
+}
+
+func TestSyncConfiguredSkills_RepeatSyncMakesNoWrites(t *testing.T) {
+	root := t.TempDir()
+	src := writeUserSkill(t, t.TempDir(), "steady-skill", "")
+	if err := os.MkdirAll(filepath.Join(src, "refs"), 0o755); err != nil { //nolint:gosec // G301: test
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "refs", "notes.md"), []byte("notes\n"), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+
+	resolved := map[string]ResolvedSkill{
+		"steady-skill": {
+			Name:         "steady-skill",
+			SourceType:   SourceTypeUser,
+			PhysicalPath: src,
+			Providers:    []string{"claude"},
+		},
+	}
+
+	if _, err := SyncConfiguredSkills(root, resolved, false, nil); err != nil {
+		t.Fatalf("first sync: %v", err)
+	}
+
+	// Backdate everything so any rewrite is visible as a changed mtime.
+	destDir := filepath.Join(root, ".claude", "skills")
+	past := time.Now().Add(-time.Hour).Truncate(time.Second)
+	before := make(map[string]time.Time)
+	err := filepath.WalkDir(destDir, func(path string, _ os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := os.Chtimes(path, past, past); err != nil {
+			return err
+		}
+		before[path] = past
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := SyncConfiguredSkills(root, resolved, false, nil); err != nil {
+		t.Fatalf("second sync: %v", err)
+	}
+
+	after := 0
+	err = filepath.WalkDir(destDir, func(path string, _ os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		after++
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		want, ok := before[path]
+		if !ok {
+			t.Errorf("second sync created %s", path)
+		} else if !info.ModTime().Equal(want) {
+			t.Errorf("second sync modified %s", path)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after != len(before) {
+		t.Errorf("expected %d entries after second sync, got %d", len(before), after)
+	}
+}
+
+func TestGetSkillsDirectoryForWorktree_ProviderAliases(t *testing.T) {
+	tests := []struct {
+		provider string
+		want     string
+	}{
+		{"claude", filepath.Join("wt", ".claude", "skills")},
+		{"cc", filepath.Join("wt", ".claude", "skills")},
+		{"Codex", filepath.Join("wt", ".codex", "skills")},
+		{"cx", filepath.Join("wt", ".codex", "skills")},
+		{"oc", filepath.Join("wt", ".opencode", "skill")},
+	}
+	for _, tt := range tests {
+		if got := GetSkillsDirectoryForWorktree("wt", tt.provider); got != tt.want {
+			t.Errorf("GetSkillsDirectoryForWorktree(%q) = %q, want %q", tt.provider, got, tt.want)
+		}
+	}
+}
+
+func TestListSkillSources_ExcludeSources(t *testing.T) {
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+	writeUserSkill(t, configHome, "user-only-skill", "")
+
+	sources := ListSkillSourcesWithOptions(nil, nil, DiscoveryOptions{ExcludeSources: []string{"user"}})
+	if _, ok := sources["user-only-skill"]; ok {
+		t.Error("expected user skill to be excluded")
+	}
+	for name, src := range sources {
+		if src.Type != SourceTypeBuiltin {
+			t.Errorf("expected only builtin skills, got %s from %s", name, src.Type)
+		}
+	}
+
+	sources = ListSkillSourcesWithOptions(nil, nil, DiscoveryOptions{ExcludeSources: []string{"builtin"}})
+	if src, ok := sources["user-only-skill"]; !ok || src.Type != SourceTypeUser {
+		t.Errorf("expected user skill when only builtin is excluded, got %+v (found=%v)", src, ok)
+	}
+	for name, src := range sources {
+		if src.Type == SourceTypeBuiltin {
+			t.Errorf("expected builtin skill %s to be excluded", name)
+		}
+	}
+}
+
+func TestSyncConfiguredSkills_ReportsPerSkillErrors(t *testing.T) {
+	root := t.TempDir()
+	// A file where the provider directory should be makes every write fail.
+	if err := os.WriteFile(filepath.Join(root, ".claude"), []byte("x"), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+	src := writeUserSkill(t, t.TempDir(), "broken-skill", "")
+	resolved := map[string]ResolvedSkill{
+		"broken-skill": {Name: "broken-skill", SourceType: SourceTypeUser, PhysicalPath: src, Providers: []string{"claude"}},
+	}
+
+	count, _, errs := syncConfiguredSkills(context.Background(), root, resolved, nil, SyncOptions{}, nil)
+	if count != 0 {
+		t.Errorf("expected no skills synced, got %d", count)
+	}
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", len(errs), errs)
+	}
+	if errs[0].Skill != "broken-skill" || errs[0].Phase != SyncPhaseWrite {
+		t.Errorf("unexpected error details: %+v", errs[0])
+	}
+	if lastSyncError(errs) == nil {
+		t.Error("expected lastSyncError to return the failure")
+	}
+}
+
+func TestSyncConfiguredSkills_StopsWhenContextDone(t *testing.T) {
+	root := t.TempDir()
+	stale := filepath.Join(root, ".claude", "skills", "stale-skill")
+	if err := os.MkdirAll(stale, 0o755); err != nil { //nolint:gosec // G301: test
+		t.Fatal(err)
+	}
+	src := writeUserSkill(t, t.TempDir(), "late-skill", "")
+	other := writeUserSkill(t, t.TempDir(), "other-skill", "")
+	resolved := map[string]ResolvedSkill{
+		"late-skill":  {Name: "late-skill", SourceType: SourceTypeUser, PhysicalPath: src, Providers: []string{"claude"}},
+		"other-skill": {Name: "other-skill", SourceType: SourceTypeUser, PhysicalPath: other, Providers: []string{"claude"}},
+	}
+
+	for _, atomic := range []bool{false, true} {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		count, pruned, errs := syncConfiguredSkills(ctx, root, resolved, nil, SyncOptions{Prune: true, Atomic: atomic}, nil)
+		if count != 0 || len(pruned) != 0 {
+			t.Errorf("atomic=%v: expected no writes after cancel, got count=%d pruned=%v", atomic, count, pruned)
+		}
+		if len(errs) != 2 || errs[0].Skill != "late-skill" || errs[1].Skill != "other-skill" {
+			t.Fatalf("atomic=%v: expected an aborted error per unwritten skill, got %v", atomic, errs)
+		}
+		for _, e := range errs {
+			if !errors.Is(e, context.Canceled) {
+				t.Errorf("atomic=%v: expected context.Canceled, got %v", atomic, e)
+			}
+		}
+		if synced := withoutFailedWrites([]string{"late-skill", "other-skill"}, errs); len(synced) != 0 {
+			t.Errorf("atomic=%v: aborted skills still reported as synced: %v", atomic, synced)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(root, ".claude", "skills", "late-skill")); !os.IsNotExist(err) {
+		t.Errorf("skill written after cancel: %v", err)
+	}
+	if _, err := os.Stat(stale); err != nil {
+		t.Errorf("stale skill pruned after cancel: %v", err)
+	}
+}
+
+func TestFindShadowedSkills_UserOverridesBuiltin(t *testing.T) {
+	builtins := ListBuiltinSkills()
+	if len(builtins) == 0 {
+		t.Skip("no builtin skills embedded")
+	}
+	name := builtins[0]
+
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+	dir := writeUserSkill(t, configHome, name, "")
+
+	resolved := map[string]ResolvedSkill{
+		name: {Name: name, SourceType: SourceTypeUser, PhysicalPath: dir},
+	}
+	shadowed := findShadowedSkills(nil, nil, resolved, DiscoveryOptions{})
+	if len(shadowed) != 1 {
+		t.Fatalf("expected 1 shadowed copy, got %d: %+v", len(shadowed), shadowed)
+	}
+	if shadowed[0].Tier != "builtin" || shadowed[0].Shadowed.Type != SourceTypeBuiltin {
+		t.Errorf("expected builtin copy to be shadowed, got %+v", shadowed[0])
+	}
+}
+
+func TestFindShadowedSkills_HonorsExcludedAndHigherTiers(t *testing.T) {
+	builtins := ListBuiltinSkills()
+	if len(builtins) == 0 {
+		t.Skip("no builtin skills embedded")
+	}
+	name := builtins[0]
+
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+	dir := writeUserSkill(t, configHome, name, "")
+
+	// The builtin copy took no part in resolution, so it shadows nothing.
+	userWins := map[string]ResolvedSkill{
+		name: {Name: name, SourceType: SourceTypeUser, PhysicalPath: dir},
+	}
+	if shadowed := findShadowedSkills(nil, nil, userWins, DiscoveryOptions{ExcludeSources: []string{"builtin"}}); len(shadowed) != 0 {
+		t.Errorf("expected excluded builtin tier to be ignored, got %+v", shadowed)
+	}
+
+	// With the user tier excluded the builtin wins; the user copy sits above
+	// it and must not be reported as shadowed.
+	src, ok := BuiltinSkillSource(name)
+	if !ok {
+		t.Fatalf("expected builtin source for %s", name)
+	}
+	builtinWins := map[string]ResolvedSkill{
+		name: {Name: name, SourceType: SourceTypeBuiltin, PhysicalPath: src.Path, RelPath: src.RelPath},
+	}
+	if shadowed := findShadowedSkills(nil, nil, builtinWins, DiscoveryOptions{ExcludeSources: []string{"user"}}); len(shadowed) != 0 {
+		t.Errorf("expected no shadowed copies below a builtin winner, got %+v", shadowed)
+	}
+}
+
+func TestSyncSkillsToDirectory_IncludesBuiltins(t *testing.T) {
+	builtins := ListBuiltinSkills()
+	if len(builtins) == 0 {
+		t.Skip("no builtin skills embedded")
+	}
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+	writeUserSkill(t, configHome, "user-extra", "")
+
+	// A user skill with a builtin's name must win over the builtin.
+	override := builtins[0]
+	writeUserSkill(t, configHome, override, "")
+
+	destDir := filepath.Join(t.TempDir(), "skills")
+	node := &workspace.WorkspaceNode{Name: "proj", Path: t.TempDir()}
+	if _, err := SyncSkillsToDirectory(nil, node, destDir); err != nil {
+		t.Fatalf("SyncSkillsToDirectory: %v", err)
+	}
+
+	for _, name := range append([]string{"user-extra"}, builtins...) {
+		if _, err := os.Stat(filepath.Join(destDir, name, "SKILL.md")); err != nil {
+			t.Errorf("expected %s to be synced: %v", name, err)
+		}
+	}
+	content, err := os.ReadFile(filepath.Join(destDir, override, "SKILL.md")) //nolint:gosec // G304: test
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "Test skill "+override) {
+		t.Errorf("expected user copy of %s to win over builtin", override)
+	}
+}
+
+func TestSyncConfiguredSkills_AppliesModes(t *testing.T) {
+	root := t.TempDir()
+	src := writeUserSkill(t, t.TempDir(), "perm-skill", "")
+	resolved := map[string]ResolvedSkill{
+		"perm-skill": {Name: "perm-skill", SourceType: SourceTypeUser, PhysicalPath: src, Providers: []string{"claude"}},
+	}
+
+	opts := SyncOptions{DirMode: 0o750, FileMode: 0o640}
+	if _, _, errs := syncConfiguredSkills(context.Background(), root, resolved, nil, opts, nil); len(errs) > 0 {
+		t.Fatalf("sync: %v", errs)
+	}
+
+	skillDir := filepath.Join(root, ".claude", "skills", "perm-skill")
+	info, err := os.Stat(skillDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o750 {
+		t.Errorf("dir mode = %o, want 750", info.Mode().Perm())
+	}
+	info, err = os.Stat(filepath.Join(skillDir, "SKILL.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o640 {
+		t.Errorf("file mode = %o, want 640", info.Mode().Perm())
+	}
+}
+
+func TestSyncConfiguredSkills_Merge(t *testing.T) {
+	tests := []struct {
+		name      string
+		merge     bool
+		wantExtra bool
+	}{
+		{"merge keeps extra files", true, true},
+		{"default removes extra files", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			src := writeUserSkill(t, t.TempDir(), "merge-skill", "")
+			resolved := map[string]ResolvedSkill{
+				"merge-skill": {Name: "merge-skill", SourceType: SourceTypeUser, PhysicalPath: src, Providers: []string{"claude"}},
+			}
+			opts := SyncOptions{Merge: tt.merge}
+			if _, _, errs := syncConfiguredSkills(context.Background(), root, resolved, nil, opts, nil); len(errs) > 0 {
+				t.Fatalf("first sync: %v", errs)
+			}
+
+			skillDir := filepath.Join(root, ".claude", "skills", "merge-skill")
+			extra := filepath.Join(skillDir, "local-notes.md")
+			if err := os.WriteFile(extra, []byte("local\n"), 0o644); err != nil { //nolint:gosec // G306: test
+				t.Fatal(err)
+			}
+			// Change the source so the second sync has to write.
+			if err := os.WriteFile(filepath.Join(src, "SKILL.md"), []byte("---\nname: merge-skill\ndescription: Updated\n---\n\nNew body.\n"), 0o644); err != nil { //nolint:gosec // G306: test
+				t.Fatal(err)
+			}
+			if _, _, errs := syncConfiguredSkills(context.Background(), root, resolved, nil, opts, nil); len(errs) > 0 {
+				t.Fatalf("second sync: %v", errs)
+			}
+
+			got, err := os.ReadFile(filepath.Join(skillDir, "SKILL.md")) //nolint:gosec // G304: test
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(got), "New body.") {
+				t.Errorf("SKILL.md was not updated: %q", got)
+			}
+			_, err = os.Stat(extra)
+			if exists := err == nil; exists != tt.wantExtra {
+				t.Errorf("extra file exists = %v, want %v", exists, tt.wantExtra)
+			}
+		})
+	}
+}
+
+func TestSyncConfiguredSkills_Hardlink(t *testing.T) {
+	root := t.TempDir()
+	src := writeUserSkill(t, t.TempDir(), "linked-skill", "")
+	srcFile := filepath.Join(src, "SKILL.md")
+	if err := os.WriteFile(srcFile, []byte("---\nname: linked-skill\ndescription: d\n---\n<!-- note -->\nBody.\n"), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+	resolved := map[string]ResolvedSkill{
+		"linked-skill": {Name: "linked-skill", SourceType: SourceTypeUser, PhysicalPath: src, Providers: []string{"claude"}},
+	}
+	destFile := filepath.Join(root, ".claude", "skills", "linked-skill", "SKILL.md")
+
+	if _, _, errs := syncConfiguredSkills(context.Background(), root, resolved, nil, SyncOptions{Hardlink: true}, nil); len(errs) > 0 {
+		t.Fatalf("sync: %v", errs)
+	}
+	srcInfo, err := os.Stat(srcFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	destInfo, err := os.Stat(destFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !os.SameFile(srcInfo, destInfo) {
+		t.Error("expected installed SKILL.md to be a hardlink to the source")
+	}
+
+	// Stripping must replace the link rather than rewrite the shared inode.
+	if _, _, errs := syncConfiguredSkills(context.Background(), root, resolved, nil, SyncOptions{Hardlink: true, Merge: true, StripComments: true}, nil); len(errs) > 0 {
+		t.Fatalf("strip sync: %v", errs)
+	}
+	content, err := os.ReadFile(srcFile) //nolint:gosec // G304: test
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "<!-- note -->") {
+		t.Error("stripping an installed hardlinked skill modified its source")
+	}
+}
+
+func TestSyncConfiguredSkills_HardlinkReplacesUpToDateCopy(t *testing.T) {
+	root := t.TempDir()
+	src := writeUserSkill(t, t.TempDir(), "copied-skill", "")
+	resolved := map[string]ResolvedSkill{
+		"copied-skill": {Name: "copied-skill", SourceType: SourceTypeUser, PhysicalPath: src, Providers: []string{"claude"}},
+	}
+	srcFile := filepath.Join(src, "SKILL.md")
+	destFile := filepath.Join(root, ".claude", "skills", "copied-skill", "SKILL.md")
+
+	for _, hardlink := range []bool{false, true, false} {
+		if _, _, errs := syncConfiguredSkills(context.Background(), root, resolved, nil, SyncOptions{Hardlink: hardlink}, nil); len(errs) > 0 {
+			t.Fatalf("sync (hardlink=%v): %v", hardlink, errs)
+		}
+		if linked := sameFile(srcFile, destFile); linked != hardlink {
+			t.Errorf("after sync with hardlink=%v, installed SKILL.md linked=%v", hardlink, linked)
+		}
+	}
+}
+
+func TestVerifyInstalledSkills(t *testing.T) {
+	root := t.TempDir()
+	src := writeUserSkill(t, t.TempDir(), "checked-skill", "")
+	resolved := map[string]ResolvedSkill{
+		"checked-skill": {Name: "checked-skill", SourceType: SourceTypeUser, PhysicalPath: src, Providers: []string{"claude"}},
+	}
+	if _, _, errs := syncConfiguredSkills(context.Background(), root, resolved, nil, SyncOptions{}, nil); len(errs) > 0 {
+		t.Fatalf("sync: %v", errs)
+	}
+	if errs := verifyInstalledSkills(root, resolved); len(errs) != 0 {
+		t.Fatalf("expected healthy install to pass, got %v", errs)
+	}
+
+	// Simulate a truncated write.
+	skillFile := filepath.Join(root, ".claude", "skills", "checked-skill", "SKILL.md")
+	if err := os.WriteFile(skillFile, []byte("---\nname: checked-sk"), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+	errs := verifyInstalledSkills(root, resolved)
+	if len(errs) != 1 || errs[0].Skill != "checked-skill" || errs[0].Phase != SyncPhaseVerify {
+		t.Errorf("expected one verify error for checked-skill, got %+v", errs)
+	}
+}
+
+func TestRepairInstalledSkill(t *testing.T) {
+	root := t.TempDir()
+	src := writeUserSkill(t, t.TempDir(), "fix-skill", "")
+	if err := os.MkdirAll(filepath.Join(src, "refs"), 0o755); err != nil { //nolint:gosec // G301: test
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "refs", "notes.md"), []byte("notes\n"), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+	r := ResolvedSkill{Name: "fix-skill", SourceType: SourceTypeUser, PhysicalPath: src, Providers: []string{"claude"}}
+	destPath := filepath.Join(root, ".claude", "skills", "fix-skill")
+
+	if result, err := repairInstalledSkill(r, destPath); err != nil || result != nil {
+		t.Fatalf("expected uninstalled skill to be skipped, got %+v, %v", result, err)
+	}
+
+	if err := installResolvedSkill(r, destPath, SyncOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.RemoveAll(filepath.Join(destPath, "refs")); err != nil {
+		t.Fatal(err)
+	}
+	result, err := repairInstalledSkill(r, destPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result == nil || result.Reinstalled || len(result.Restored) != 1 || result.Restored[0] != "refs/notes.md" {
+		t.Fatalf("expected refs/notes.md to be restored, got %+v", result)
+	}
+
+	if err := os.Remove(filepath.Join(destPath, "SKILL.md")); err != nil {
+		t.Fatal(err)
+	}
+	result, err = repairInstalledSkill(r, destPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result == nil || !result.Reinstalled {
+		t.Fatalf("expected reinstall when SKILL.md is missing, got %+v", result)
+	}
+	if result, _ := repairInstalledSkill(r, destPath); result != nil {
+		t.Errorf("expected complete skill to need no repair, got %+v", result)
+	}
+}
+
+func TestListSkillSources_EmbeddedOnly(t *testing.T) {
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+	writeUserSkill(t, configHome, "host-skill", "")
+
+	svc := &service.Service{EmbeddedOnly: true}
+	sources := ListSkillSources(svc, nil)
+	if _, ok := sources["host-skill"]; ok {
+		t.Error("expected user skills to be ignored when embedded-only")
+	}
+	if len(sources) == 0 {
+		t.Fatal("expected builtin skills to remain")
+	}
+	for name, src := range sources {
+		if src.Type != SourceTypeBuiltin {
+			t.Errorf("skill %s has source %s, want builtin", name, src.Type)
+		}
+	}
+	for _, name := range QuickSkillNames(svc) {
+		if name == "host-skill" {
+			t.Error("expected QuickSkillNames to skip user skills when embedded-only")
+		}
+	}
+}
+
+func TestSyncConfiguredSkills_RefusesSourceInsideDestination(t *testing.T) {
+	root := t.TempDir()
+	skillsDir := filepath.Join(root, ".claude", "skills")
+	src := writeUserSkill(t, filepath.Join(skillsDir, "nested"), "self-skill", "")
+	resolved := map[string]ResolvedSkill{
+		"self-skill": {Name: "self-skill", SourceType: SourceTypeUser, PhysicalPath: src, Providers: []string{"claude"}},
+	}
+
+	_, _, errs := syncConfiguredSkills(context.Background(), root, resolved, nil, SyncOptions{}, nil)
+	if len(errs) != 1 || errs[0].Skill != "self-skill" || !strings.Contains(errs[0].Err.Error(), "overlap") {
+		t.Fatalf("expected an overlap error for self-skill, got %v", errs)
+	}
+	if _, err := os.Stat(filepath.Join(src, "SKILL.md")); err != nil {
+		t.Errorf("source skill was modified: %v", err)
+	}
+
+	// Installing a skill onto its own source directory must not wipe it.
+	if err := installResolvedSkill(resolved["self-skill"], src, SyncOptions{}); err == nil {
+		t.Error("expected installing a skill onto its source to fail")
+	}
+	if _, err := os.Stat(filepath.Join(src, "SKILL.md")); err != nil {
+		t.Errorf("source skill was removed: %v", err)
+	}
+}
+
+func TestAddSkillSources_Symlinks(t *testing.T) {
+	external := writeUserSkill(t, t.TempDir(), "linked-skill", "")
+	root := t.TempDir()
+	if err := os.Symlink(external, filepath.Join(root, "linked-skill")); err != nil {
+		t.Skipf("symlinks unavailable: %v", err)
+	}
+	// A loop back to the root must not hang discovery.
+	if err := os.Symlink(root, filepath.Join(root, "loop")); err != nil {
+		t.Fatal(err)
+	}
+
+	sources := make(map[string]SkillSource)
+	addSkillSources(nil, root, SourceTypeEcosystem, sources)
+	if _, ok := sources["linked-skill"]; !ok {
+		t.Errorf("expected symlinked skill to be discovered, got %v", sources)
+	}
+
+	sources = make(map[string]SkillSource)
+	addSkillSources(&service.Service{NoFollowSymlinks: true}, root, SourceTypeEcosystem, sources)
+	if len(sources) != 0 {
+		t.Errorf("expected symlinked skills to be ignored, got %v", sources)
+	}
+}
+
+func TestPruneExtraPaths(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"kept-skill", "stale-skill"} {
+		if err := os.MkdirAll(filepath.Join(dir, name), 0o755); err != nil { //nolint:gosec // G301: test
+			t.Fatal(err)
+		}
+	}
+	keep := map[string]bool{"kept-skill": true}
+	stale := filepath.Join(dir, "stale-skill")
+
+	if got := pruneExtraPaths([]string{dir}, keep, true); len(got) != 1 || got[0] != stale {
+		t.Fatalf("dry run = %v, want [%s]", got, stale)
+	}
+	if _, err := os.Stat(stale); err != nil {
+		t.Fatal("dry run removed a directory")
+	}
+
+	if got := pruneExtraPaths([]string{dir}, keep, false); len(got) != 1 || got[0] != stale {
+		t.Fatalf("prune = %v, want [%s]", got, stale)
+	}
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Error("expected stale skill to be removed")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "kept-skill")); err != nil {
+		t.Error("configured skill was removed")
+	}
+}
+
+func TestPruneToEmpty_RequiresAllow(t *testing.T) {
+	root := t.TempDir()
+	installed := filepath.Join(GetSkillsDirectoryForWorktree(root, "claude"), "old-skill")
+	if err := os.MkdirAll(installed, 0o755); err != nil { //nolint:gosec // G301: test
+		t.Fatal(err)
+	}
+
+	result := &SyncResult{}
+	pruneToEmpty(result, root, []string{"claude"}, SyncOptions{Prune: true}, "no skills are configured")
+	if result.PruneSkipped == "" || len(result.PrunedPaths) != 0 {
+		t.Fatalf("expected prune to be skipped, got %+v", result)
+	}
+	if _, err := os.Stat(installed); err != nil {
+		t.Fatal("skipped prune removed an installed skill")
+	}
+
+	result = &SyncResult{}
+	pruneToEmpty(result, root, []string{"claude"}, SyncOptions{Prune: true, AllowEmptyPrune: true}, "no skills are configured")
+	if result.PruneSkipped != "" || len(result.PrunedPaths) != 1 {
+		t.Fatalf("expected old-skill to be pruned, got %+v", result)
+	}
+	if _, err := os.Stat(installed); !os.IsNotExist(err) {
+		t.Error("expected old-skill to be removed")
+	}
+}
+
+func TestEnsureSkillsBaseDir_NoCreateBase(t *testing.T) {
+	dir := GetSkillsDirectoryForWorktree(t.TempDir(), "claude")
+
+	if err := ensureSkillsBaseDir(dir, SyncOptions{NoCreateBase: true}); err == nil {
+		t.Fatal("expected an error for a missing skills directory")
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Fatal("--no-create-base created the skills directory")
+	}
+
+	if err := ensureSkillsBaseDir(dir, SyncOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ensureSkillsBaseDir(dir, SyncOptions{NoCreateBase: true}); err != nil {
+		t.Errorf("expected an existing skills directory to be accepted, got %v", err)
+	}
+}
+
+func TestInstallResolvedSkill_ReadOnly(t *testing.T) {
+	src := writeUserSkill(t, t.TempDir(), "locked-skill", "")
+	if err := os.MkdirAll(filepath.Join(src, "references"), 0o755); err != nil { //nolint:gosec // G301: test
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "references", "a.md"), []byte("a\n"), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+	r := ResolvedSkill{Name: "locked-skill", SourceType: SourceTypeUser, PhysicalPath: src, Providers: []string{"claude"}}
+	destPath := filepath.Join(t.TempDir(), ".claude", "skills", "locked-skill")
+	opts := SyncOptions{DirMode: ReadOnlyDirMode, FileMode: ReadOnlyFileMode}
+
+	if err := installResolvedSkill(r, destPath, opts); err != nil {
+		t.Fatal(err)
+	}
+	for path, want := range map[string]os.FileMode{
+		destPath:                              ReadOnlyDirMode,
+		filepath.Join(destPath, "references"): ReadOnlyDirMode,
+		filepath.Join(destPath, "references", "a.md"): ReadOnlyFileMode,
+	} {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != want {
+			t.Errorf("%s mode = %o, want %o", path, info.Mode().Perm(), want)
+		}
+	}
+
+	// A changed source must replace the read-only install, with or without merge.
+	for _, merge := range []bool{false, true} {
+		if err := os.WriteFile(filepath.Join(src, "references", "a.md"), []byte(fmt.Sprintf("merge=%v\n", merge)), 0o644); err != nil { //nolint:gosec // G306: test
+			t.Fatal(err)
+		}
+		opts.Merge = merge
+		if err := installResolvedSkill(r, destPath, opts); err != nil {
+			t.Fatalf("reinstall (merge=%v): %v", merge, err)
+		}
+	}
+
+	if err := RemoveSkillDir(destPath); err != nil {
+		t.Fatalf("removing read-only install: %v", err)
+	}
+	if _, err := os.Stat(destPath); !os.IsNotExist(err) {
+		t.Error("expected read-only install to be removed")
+	}
+}
+
+func TestInstallResolvedSkill_LinkSource(t *testing.T) {
+	root := t.TempDir()
+	src := writeUserSkill(t, t.TempDir(), "linked-skill", "")
+	r := ResolvedSkill{Name: "linked-skill", SourceType: SourceTypeUser, PhysicalPath: src, Providers: []string{"claude"}}
+	destPath := filepath.Join(root, ".claude", "skills", "linked-skill")
+
+	if err := installResolvedSkill(r, destPath, SyncOptions{LinkSource: true}); err != nil {
+		t.Fatal(err)
+	}
+	if target, err := os.Readlink(destPath); err != nil || target != src {
+		t.Fatalf("expected symlink to %s, got %q, %v", src, target, err)
+	}
+
+	// Syncing again without --link-source replaces the link with a copy and
+	// leaves the source untouched.
+	if err := installResolvedSkill(r, destPath, SyncOptions{Merge: true}); err != nil {
+		t.Fatal(err)
+	}
+	if isSymlink(destPath) {
+		t.Fatal("expected linked install to be replaced by a copy")
+	}
+	if _, err := os.Stat(filepath.Join(src, "SKILL.md")); err != nil {
+		t.Fatalf("source SKILL.md should survive: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(destPath, "SKILL.md")); err != nil {
+		t.Fatalf("expected copied SKILL.md: %v", err)
+	}
+}
+
+func TestNotebookOverride(t *testing.T) {
+	cfg := &config.Config{Notebooks: &config.NotebooksConfig{Definitions: map[string]*config.Notebook{
+		"work": {RootDir: "/tmp/work"},
+	}}}
+	if err := ValidateNotebookName(cfg, "work"); err != nil {
+		t.Errorf("expected 'work' to be valid, got %v", err)
+	}
+	if err := ValidateNotebookName(cfg, "home"); err == nil || !strings.Contains(err.Error(), "work") {
+		t.Errorf("expected unknown notebook error listing 'work', got %v", err)
+	}
+
+	node := &workspace.WorkspaceNode{Name: "proj", NotebookName: "default"}
+	if got := withNotebook(nil, node); got != node {
+		t.Error("expected node unchanged without an override")
+	}
+	if got := withNotebook(&service.Service{Notebook: "work"}, node); got.NotebookName != "work" || node.NotebookName != "default" {
+		t.Errorf("expected overridden copy, got %q (original %q)", got.NotebookName, node.NotebookName)
+	}
+}
+
+func TestPlanInstalls(t *testing.T) {
+	root := t.TempDir()
+	srcDir := t.TempDir()
+	resolved := map[string]ResolvedSkill{}
+	for _, name := range []string{"current-skill", "new-skill"} {
+		src := writeUserSkill(t, srcDir, name, "")
+		resolved[name] = ResolvedSkill{Name: name, SourceType: SourceTypeUser, PhysicalPath: src, Providers: []string{"claude"}}
+	}
+	current := map[string]ResolvedSkill{"current-skill": resolved["current-skill"]}
+	if _, _, errs := syncConfiguredSkills(context.Background(), root, current, nil, SyncOptions{}, nil); len(errs) > 0 {
+		t.Fatalf("sync: %v", errs)
+	}
+
+	planned := planInstalls(root, resolved, nil, SyncOptions{})
+	want := filepath.Join(root, ".claude", "skills", "new-skill")
+	if len(planned) != 1 || planned[0] != want {
+		t.Errorf("planInstalls = %v, want [%s]", planned, want)
+	}
+	if _, err := os.Stat(want); !os.IsNotExist(err) {
+		t.Errorf("planning wrote %s: %v", want, err)
+	}
+}
+
+func TestSplitPlannedSkills(t *testing.T) {
+	root := t.TempDir()
+	src := writeUserSkill(t, t.TempDir(), "steady-skill", "")
+	resolved := map[string]ResolvedSkill{
+		"steady-skill": {Name: "steady-skill", SourceType: SourceTypeUser, PhysicalPath: src, Providers: []string{"claude"}},
+	}
+	names := []string{"steady-skill"}
+
+	changed, upToDate := splitPlannedSkills(names, planInstalls(root, resolved, nil, SyncOptions{}))
+	if len(changed) != 1 || len(upToDate) != 0 {
+		t.Errorf("before sync: changed=%v upToDate=%v", changed, upToDate)
+	}
+	if _, _, errs := syncConfiguredSkills(context.Background(), root, resolved, nil, SyncOptions{}, nil); len(errs) > 0 {
+		t.Fatalf("sync: %v", errs)
+	}
+	changed, upToDate = splitPlannedSkills(names, planInstalls(root, resolved, nil, SyncOptions{}))
+	if len(changed) != 0 || len(upToDate) != 1 {
+		t.Errorf("after sync: changed=%v upToDate=%v", changed, upToDate)
+	}
+	// Switching to a linked install is a change even though content matches.
+	changed, _ = splitPlannedSkills(names, planInstalls(root, resolved, nil, SyncOptions{LinkSource: true}))
+	if len(changed) != 1 {
+		t.Errorf("expected --link-source to replace the copy, got changed=%v", changed)
+	}
+}
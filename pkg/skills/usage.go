@@ -0,0 +1,48 @@
+package skills
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ReadUsageLog reads a skill usage log: the names of skills an agent invoked,
+// either as a JSON array of strings or as plain text with one name per line
+// (blank lines and lines starting with '#' are ignored). Names may repeat and
+// may be workspace-qualified ("workspace:skill"); the returned set holds the
+// unqualified names.
+func ReadUsageLog(path string) (map[string]bool, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: user-supplied log path
+	if err != nil {
+		return nil, fmt.Errorf("failed to read usage log: %w", err)
+	}
+
+	var names []string
+	if trimmed := bytes.TrimSpace(data); bytes.HasPrefix(trimmed, []byte("[")) {
+		if err := json.Unmarshal(trimmed, &names); err != nil {
+			return nil, fmt.Errorf("invalid usage log %s: expected a JSON array of skill names: %w", path, err)
+		}
+	} else {
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			names = append(names, line)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read usage log: %w", err)
+		}
+	}
+
+	used := make(map[string]bool, len(names))
+	for _, name := range names {
+		_, unqualified := ResolveQualifiedSkillName(strings.TrimSpace(name))
+		used[unqualified] = true
+	}
+	return used, nil
+}
@@ -0,0 +1,35 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadUsageLog(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"lines": "# invocations\nreview\n\neco:explain\nreview\n",
+		"json":  `["review", "eco:explain"]`,
+	} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil { //nolint:gosec // G306: test
+			t.Fatal(err)
+		}
+		used, err := ReadUsageLog(path)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(used) != 2 || !used["review"] || !used["explain"] {
+			t.Errorf("%s: used = %v, want review and explain", name, used)
+		}
+	}
+
+	bad := filepath.Join(dir, "bad")
+	if err := os.WriteFile(bad, []byte(`["review", 3]`), 0o644); err != nil { //nolint:gosec // G306: test
+		t.Fatal(err)
+	}
+	if _, err := ReadUsageLog(bad); err == nil {
+		t.Error("expected an error for a malformed JSON usage log")
+	}
+}